@@ -29,20 +29,25 @@ const (
 
 // ClientConf 客户端配置
 type ClientConf struct {
-	Token        string        // Token
-	Endpoint     string        // API 地址，默认 https://api.tushare.pro
-	Limit        int           // 每页数据限制，默认 5000
-	Retries      int           // 最大重试次数，默认 3
-	Interval     time.Duration // 初始重试间隔，默认 1s
-	MaxInterval  time.Duration // 最大重试间隔，默认 30s
-	Timeout      time.Duration // HTTP 超时，默认 30s
-	UseBackoff   bool          // 是否使用指数退避，默认 true
+	Token         string        // Token
+	Endpoint      string        // API 地址，默认 https://api.tushare.pro
+	Limit         int           // 每页数据限制，默认 5000
+	Retries       int           // 最大重试次数，默认 3
+	Interval      time.Duration // 初始重试间隔，默认 1s
+	MaxInterval   time.Duration // 最大重试间隔，默认 30s
+	Timeout       time.Duration // HTTP 超时，默认 30s
+	UseBackoff    bool          // 是否使用指数退避，默认 true
+	RateLimit     int           // 每分钟最大请求数，默认 0 表示不限频
+	MaxStarvation int           // 低优先级请求最多被连续插队的次数，默认 5
 }
 
 // Client Tushare API 客户端
 type Client struct {
-	conf   *ClientConf
-	client *http.Client
+	conf        *ClientConf
+	client      *http.Client
+	scheduler   *scheduler
+	middlewares []Middleware
+	handler     Handler
 }
 
 // ClientOption 客户端配置选项
@@ -105,6 +110,27 @@ func WithBackoff(useBackoff bool) ClientOption {
 	}
 }
 
+// WithRateLimit 设置每分钟最大请求数，启用按优先级调度的限频
+func WithRateLimit(perMinute int) ClientOption {
+	return func(c *Client) {
+		c.conf.RateLimit = perMinute
+	}
+}
+
+// WithMaxStarvation 设置低优先级请求最多被连续插队的次数
+func WithMaxStarvation(n int) ClientOption {
+	return func(c *Client) {
+		c.conf.MaxStarvation = n
+	}
+}
+
+// WithMiddleware 追加请求中间件，先添加的中间件位于外层
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
 // NewClient 创建新的 Tushare 客户端（兼容旧版本）
 func NewClient(token string, opts ...ClientOption) *Client {
 	conf := &ClientConf{
@@ -128,6 +154,7 @@ func NewClient(token string, opts ...ClientOption) *Client {
 	for _, opt := range opts {
 		opt(client)
 	}
+	client.init()
 
 	return client
 }
@@ -164,15 +191,24 @@ func NewClientWithConf(conf ClientConf, opts ...ClientOption) *Client {
 	for _, opt := range opts {
 		opt(client)
 	}
+	client.init()
 
 	return client
 }
 
+// init 根据配置初始化限频调度器和请求处理链
+func (c *Client) init() {
+	c.scheduler = newSchedulerPerMinute(c.conf.RateLimit, c.conf.MaxStarvation)
+	c.handler = c.buildHandler()
+}
+
 // QueryOption 查询选项
 type QueryOption func(*queryOptions)
 
 type queryOptions struct {
-	ctx context.Context
+	ctx      context.Context
+	priority Priority
+	gates    []*requestGate // 每次 HTTP 请求前需要通过的限频关卡（由 RateLimitedQuerier 添加）
 }
 
 // WithContext 添加上下文选项（用于超时控制）
@@ -182,11 +218,28 @@ func WithContext(ctx context.Context) QueryOption {
 	}
 }
 
+// WithPriority 设置请求优先级（仅在启用限频时生效）
+func WithPriority(p Priority) QueryOption {
+	return func(o *queryOptions) {
+		o.priority = p
+	}
+}
+
 // defaultQueryOptions 默认查询选项
 func defaultQueryOptions() *queryOptions {
 	return &queryOptions{
-		ctx: context.Background(),
+		ctx:      context.Background(),
+		priority: PriorityNormal,
+	}
+}
+
+// applyQueryOptions 合并查询选项
+func applyQueryOptions(opts []QueryOption) *queryOptions {
+	options := defaultQueryOptions()
+	for _, opt := range opts {
+		opt(options)
 	}
+	return options
 }
 
 // RequestParams 请求参数
@@ -199,12 +252,40 @@ type RequestParams struct {
 
 // Query 执行通用查询（自动处理分页，一次性获取所有数据）
 func (c *Client) Query(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
-	// 合并选项
-	options := defaultQueryOptions()
-	for _, opt := range opts {
-		opt(options)
+	// 合并所有数据
+	allItems := make([][]interface{}, 0)
+	var respFields []string
+
+	resp, err := c.paginate(apiName, params, fields, applyQueryOptions(opts), func(page *ResponseData) error {
+		respFields = page.Fields
+		allItems = append(allItems, page.Items...)
+		return nil
+	})
+	if err != nil {
+		return resp, err
 	}
 
+	// 构造合并后的响应
+	return &Response{
+		Code: CodeOK,
+		Msg:  "",
+		Data: &ResponseData{
+			Fields:  respFields,
+			Items:   allItems,
+			HasMore: false,
+		},
+	}, nil
+}
+
+// QueryPages 执行通用查询并逐页回调（自动处理分页），适用于全市场下载等不宜在内存中合并全部数据的场景
+// 每获取一页调用一次 fn，fn 返回错误时停止分页并返回该错误
+func (c *Client) QueryPages(apiName string, params map[string]interface{}, fields string, fn func(page *ResponseData) error, opts ...QueryOption) error {
+	_, err := c.paginate(apiName, params, fields, applyQueryOptions(opts), fn)
+	return err
+}
+
+// paginate 按 offset 逐页请求，API 返回错误时同时返回该响应
+func (c *Client) paginate(apiName string, params map[string]interface{}, fields string, options *queryOptions, fn func(page *ResponseData) error) (*Response, error) {
 	// 复制参数，避免修改原始参数
 	newParams := make(map[string]interface{})
 	for k, v := range params {
@@ -215,10 +296,6 @@ func (c *Client) Query(apiName string, params map[string]interface{}, fields str
 	newParams["limit"] = c.conf.Limit
 	newParams["offset"] = 0
 
-	// 合并所有数据
-	allItems := make([][]interface{}, 0)
-	var respFields []string
-
 	for {
 		// 检查上下文是否已取消
 		select {
@@ -227,7 +304,7 @@ func (c *Client) Query(apiName string, params map[string]interface{}, fields str
 		default:
 		}
 
-		resp, err := c.postWithRetry(apiName, newParams, fields, options.ctx)
+		resp, err := c.postWithRetry(apiName, newParams, fields, options)
 		if err != nil {
 			return nil, err
 		}
@@ -239,43 +316,30 @@ func (c *Client) Query(apiName string, params map[string]interface{}, fields str
 			}
 		}
 
-		if resp.Data != nil {
-			respFields = resp.Data.Fields
-			allItems = append(allItems, resp.Data.Items...)
+		// 没有数据时退出循环
+		if resp.Data == nil {
+			return nil, nil
+		}
+		if err := fn(resp.Data); err != nil {
+			return nil, err
+		}
 
-			// 如果没有更多数据，退出循环
-			if !resp.Data.HasMore {
-				break
-			}
-		} else {
-			break
+		// 如果没有更多数据，退出循环
+		if !resp.Data.HasMore {
+			return nil, nil
 		}
 
 		// 更新 offset 继续获取下一页
 		offset, _ := newParams["offset"].(int)
 		newParams["offset"] = offset + c.conf.Limit
 	}
-
-	// 构造合并后的响应
-	return &Response{
-		Code: CodeOK,
-		Msg:  "",
-		Data: &ResponseData{
-			Fields:  respFields,
-			Items:   allItems,
-			HasMore: false,
-		},
-	}, nil
 }
 
 // QueryOne 执行单次查询（不处理分页，用于确定数据量小的场景）
 func (c *Client) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
-	options := defaultQueryOptions()
-	for _, opt := range opts {
-		opt(options)
-	}
+	options := applyQueryOptions(opts)
 
-	return c.postWithRetry(apiName, params, fields, options.ctx)
+	return c.postWithRetry(apiName, params, fields, options)
 }
 
 // isRetryableError 判断错误是否可重试
@@ -299,85 +363,40 @@ func (c *Client) notifyRetry(err error, duration time.Duration) {
 	// 例如: log.Printf("请求失败，%v 后重试，错误: %v", duration, err)
 }
 
-// postWithRetry 发送 POST 请求（使用 backoff 实现重试）
-func (c *Client) postWithRetry(apiName string, params map[string]interface{}, fields string, ctx context.Context) (*Response, error) {
-	reqParams := RequestParams{
-		APIName: apiName,
-		Token:   c.conf.Token,
-		Params:  params,
-		Fields:  fields,
-	}
-
-	var resp *Response
-
-	// 定义重试操作
-	operation := func() error {
-		var err error
-		resp, err = c.doRequest(reqParams, ctx)
-
-		// 成功直接返回
-		if err == nil && resp.IsSuccess() {
-			return nil
-		}
-
-		// 判断是否可重试
-		if !isRetryableError(resp, err) {
-			// 不可重试的错误，使用 backoff.Permanent 终止重试
-			if err != nil {
-				return backoff.Permanent(err)
-			}
-			// API 业务错误（非限频），不重试但返回结果
-			return nil
-		}
-
-		// 可重试的错误
-		if err != nil {
-			return err
-		}
-		return fmt.Errorf("api error: code=%d, msg=%s", resp.Code, resp.Msg)
-	}
-
-	// 配置 backoff 策略
-	var b backoff.BackOff
-	if c.conf.UseBackoff {
-		// 指数退避
-		expBackoff := backoff.NewExponentialBackOff()
-		expBackoff.InitialInterval = c.conf.Interval
-		expBackoff.MaxInterval = c.conf.MaxInterval
-		expBackoff.Multiplier = 2
-		expBackoff.RandomizationFactor = 0.1
-		b = backoff.WithMaxRetries(expBackoff, uint64(c.conf.Retries))
-	} else {
-		// 固定间隔退避
-		constBackoff := backoff.NewConstantBackOff(c.conf.Interval)
-		b = backoff.WithMaxRetries(constBackoff, uint64(c.conf.Retries))
-	}
-
-	// 包装上下文支持取消
-	b = backoff.WithContext(b, ctx)
-
-	// 执行重试
-	err := backoff.RetryNotify(operation, b, c.notifyRetry)
-	if err != nil {
-		return nil, err
+// postWithRetry 发送 POST 请求（经过重试、中间件和限频组成的处理链）
+func (c *Client) postWithRetry(apiName string, params map[string]interface{}, fields string, options *queryOptions) (*Response, error) {
+	req := &Request{
+		Params: RequestParams{
+			APIName: apiName,
+			Token:   c.conf.Token,
+			Params:  params,
+			Fields:  fields,
+		},
+		Ctx:      options.ctx,
+		Header:   make(http.Header),
+		Priority: options.priority,
+		gates:    options.gates,
 	}
 
-	return resp, nil
+	return c.handler(req)
 }
 
-// doRequest 执行 HTTP 请求
-func (c *Client) doRequest(reqParams RequestParams, ctx context.Context) (*Response, error) {
-	jsonBody, err := json.Marshal(reqParams)
+// doRequest 执行 HTTP 请求，是处理链的最内层
+func (c *Client) doRequest(r *Request) (*Response, error) {
+	jsonBody, err := json.Marshal(r.Params)
 	if err != nil {
 		return nil, fmt.Errorf("marshal request failed: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.conf.Endpoint, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(r.Ctx, http.MethodPost, c.conf.Endpoint, bytes.NewBuffer(jsonBody))
 	if err != nil {
 		return nil, fmt.Errorf("create request failed: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	for key, values := range r.Header {
+		req.Header[key] = values
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -411,6 +430,14 @@ func (c *Client) QueryAsDataFrame(apiName string, params map[string]interface{},
 	return NewDataFrame(resp), nil
 }
 
+// SchedulerStats 返回限频调度器的排队统计（未启用限频时返回空统计）
+func (c *Client) SchedulerStats() SchedulerStats {
+	if c.scheduler == nil {
+		return SchedulerStats{ByPriority: map[Priority]PriorityStats{}}
+	}
+	return c.scheduler.snapshot()
+}
+
 // ==================== 向后兼容的方法 ====================
 
 // QueryWithContext 执行带上下文的通用查询（兼容旧版本，实际等价于 Query）
@@ -0,0 +1,111 @@
+package tushare
+
+import (
+	"fmt"
+)
+
+// Concat 纵向拼接多个 DataFrame（如按股票或按日期分批获取的数据）
+//
+// 结果的列为各 DataFrame 列的并集：先按第一个 DataFrame 的列顺序，再追加其后新出现的列；
+// DataFrame 中缺少的列以空值填充。同名列类型不同时，均为数值则合并为 float64，否则合并为字符串；
+// 全部为空值的列不参与类型合并，列类型由含有非空值的 DataFrame 决定。
+// nil 会被忽略。
+func Concat(dfs ...*DataFrame) *DataFrame {
+	var names []string
+	types := make(map[string]ColumnType)
+	typed := make(map[string]bool) // 类型是否来自含有非空值的列
+	total := 0
+	for _, df := range dfs {
+		if df == nil {
+			continue
+		}
+		total += df.nrows
+		for _, s := range df.series {
+			// 全部为空值的列（如某批数据中的 delist_date）推断出的类型没有意义，不参与合并
+			empty := s.NullCount() == s.Len()
+			typ, ok := types[s.name]
+			switch {
+			case !ok:
+				names = append(names, s.name)
+				types[s.name] = s.typ
+				typed[s.name] = !empty
+			case empty:
+			case !typed[s.name]:
+				types[s.name] = s.typ
+				typed[s.name] = true
+			default:
+				types[s.name] = unifyTypes(typ, s.typ)
+			}
+		}
+	}
+
+	series := make([]*Series, len(names))
+	for j, name := range names {
+		result := newSeries(name, types[name], total)
+		for _, df := range dfs {
+			if df == nil {
+				continue
+			}
+			s := df.Column(name)
+			for i := 0; i < df.nrows; i++ {
+				if s == nil {
+					result.appendNull()
+				} else {
+					result.appendFrom(s, i)
+				}
+			}
+		}
+		series[j] = result
+	}
+
+	result := newDataFrame(series)
+	result.nrows = total
+	return result
+}
+
+// Keep DropDuplicates 保留重复行中的哪一行
+type Keep int
+
+const (
+	// KeepFirst 保留第一次出现的行
+	KeepFirst Keep = iota
+	// KeepLast 保留最后一次出现的行（如分批数据中较新的一批）
+	KeepLast
+)
+
+// DropDuplicates 删除 keys 列取值重复的行，keys 为空时比较所有列；保留的行维持原有顺序
+// 空值与空值视为相同
+func (df *DataFrame) DropDuplicates(keys []string, keep Keep) (*DataFrame, error) {
+	series := df.series
+	if len(keys) > 0 {
+		var err error
+		if series, err = df.columns(keys); err != nil {
+			return nil, err
+		}
+	}
+	if keep != KeepFirst && keep != KeepLast {
+		return nil, fmt.Errorf("unsupported keep: %d", keep)
+	}
+
+	kept := make(map[string]int, df.nrows)
+	var buf []byte
+	for i := 0; i < df.nrows; i++ {
+		buf = appendRowKey(buf[:0], series, i, false)
+		if _, ok := kept[string(buf)]; ok && keep == KeepFirst {
+			continue
+		}
+		kept[string(buf)] = i
+	}
+
+	keepRow := make([]bool, df.nrows)
+	for _, i := range kept {
+		keepRow[i] = true
+	}
+	idx := make([]int, 0, len(kept))
+	for i, ok := range keepRow {
+		if ok {
+			idx = append(idx, i)
+		}
+	}
+	return df.take(idx), nil
+}
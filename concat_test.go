@@ -0,0 +1,111 @@
+package tushare
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	first := newTestFrame(t,
+		NewStringSeries("ts_code", []string{"000001.SZ", "000002.SZ"}, nil),
+		NewInt64Series("vol", []int64{100, 200}, nil),
+	)
+	second := newTestFrame(t,
+		NewStringSeries("name", []string{"浦发银行"}, nil),
+		NewFloat64Series("vol", []float64{1.5}, nil),
+		NewStringSeries("ts_code", []string{"600000.SH"}, nil),
+	)
+
+	result := Concat(first, nil, second)
+	if result.Len() != 3 {
+		t.Fatalf("期望 3 行，但得到 %d", result.Len())
+	}
+	// 先按第一个 DataFrame 的列顺序，再追加新列
+	assertStrings(t, result.Columns, []string{"ts_code", "vol", "name"})
+	assertStrings(t, columnStrings(result, "ts_code"), []string{"000001.SZ", "000002.SZ", "600000.SH"})
+
+	// int64 与 float64 合并为 float64
+	if result.Column("vol").Type() != ColumnFloat64 {
+		t.Errorf("期望 vol 合并为 float64，但得到 %s", result.Column("vol").Type())
+	}
+	if result.GetFloat64(2, "vol") != 1.5 {
+		t.Errorf("期望 vol 为 1.5，但得到 %f", result.GetFloat64(2, "vol"))
+	}
+
+	// 缺少的列以空值填充
+	if !result.IsNull(0, "name") || result.GetString(2, "name") != "浦发银行" {
+		t.Error("期望第一个 DataFrame 的 name 为空值")
+	}
+
+	if empty := Concat(); empty.Len() != 0 || len(empty.Columns) != 0 {
+		t.Error("期望空参数返回空 DataFrame")
+	}
+}
+
+func TestConcat_AllNullChunk(t *testing.T) {
+	// 第二批股票均未退市，delist_date 全部为空，推断为 float64
+	listed := NewDataFrame(&Response{Data: &ResponseData{
+		Fields: []string{"ts_code", "delist_date"},
+		Items:  [][]interface{}{{"000003.SZ", "20020614"}},
+	}})
+	active := NewDataFrame(&Response{Data: &ResponseData{
+		Fields: []string{"ts_code", "delist_date"},
+		Items:  [][]interface{}{{"000001.SZ", nil}, {"000002.SZ", nil}},
+	}})
+	if active.Column("delist_date").Type() == ColumnDate {
+		t.Fatal("测试前提不成立：全空列不应推断为日期")
+	}
+
+	for _, result := range []*DataFrame{Concat(listed, active), Concat(active, listed)} {
+		if got := result.Column("delist_date").Type(); got != ColumnDate {
+			t.Errorf("期望 delist_date 保持日期类型，但得到 %s", got)
+		}
+		if result.Len() != 3 || result.Column("delist_date").NullCount() != 2 {
+			t.Errorf("期望 3 行且 2 个空值，但得到 %v", result.Records())
+		}
+	}
+
+	// 所有批次都为空时保留第一个的类型
+	if got := Concat(active, active).Column("delist_date").Type(); got != active.Column("delist_date").Type() {
+		t.Errorf("期望保留原类型，但得到 %s", got)
+	}
+}
+
+func TestDataFrame_DropDuplicates(t *testing.T) {
+	// 两批数据在 20240103 重叠，后一批为修正后的数据
+	batch1 := NewDataFrame(&Response{Data: &ResponseData{
+		Fields: []string{"ts_code", "trade_date", "close"},
+		Items: [][]interface{}{
+			{"000001.SZ", "20240102", 9.0},
+			{"000001.SZ", "20240103", 9.1},
+		},
+	}})
+	batch2 := NewDataFrame(&Response{Data: &ResponseData{
+		Fields: []string{"ts_code", "trade_date", "close"},
+		Items: [][]interface{}{
+			{"000001.SZ", "20240103", 9.2},
+			{"000001.SZ", "20240104", 9.3},
+		},
+	}})
+	all := Concat(batch1, batch2)
+
+	first, err := all.DropDuplicates([]string{"ts_code", "trade_date"}, KeepFirst)
+	if err != nil {
+		t.Fatalf("DropDuplicates 失败: %v", err)
+	}
+	assertStrings(t, columnStrings(first, "close"), []string{"9", "9.1", "9.3"})
+
+	last, _ := all.DropDuplicates([]string{"ts_code", "trade_date"}, KeepLast)
+	assertStrings(t, columnStrings(last, "close"), []string{"9", "9.2", "9.3"})
+
+	// 不指定键列时比较所有列
+	dup := Concat(batch1, batch1)
+	unique, _ := dup.DropDuplicates(nil, KeepFirst)
+	if unique.Len() != 2 {
+		t.Errorf("期望去重后 2 行，但得到 %d", unique.Len())
+	}
+
+	if _, err := all.DropDuplicates([]string{"missing"}, KeepFirst); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package tushare
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// utf8BOM UTF-8 字节顺序标记，Excel 依赖它识别 UTF-8 编码的中文
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// CSVOptions CSV 读写选项，nil 表示使用默认值
+type CSVOptions struct {
+	Delimiter rune     // 分隔符，默认 ','
+	NoHeader  bool     // 不写入表头；读取时表示第一行即为数据
+	NullToken string   // 空值的文本表示，默认空字符串
+	BOM       bool     // 写入时在开头添加 UTF-8 BOM（读取时总是自动跳过 BOM）
+	Columns   []string // 读取时的列名，NoHeader 为 true 时必须设置；为空时使用表头
+	Schema    Schema   // 读取时指定列类型，优先于表头中的类型，未指定的列自动推断
+
+	// TypedHeader 表头写为 "列名:类型"（如 vol:int64、symbol:string），读取时据此恢复列类型并去掉类型后缀，
+	// 用于 WriteCSV 与 ReadCSV 之间无损地保存和重新加载；读写两端需要同时设置
+	TypedHeader bool
+}
+
+// delimiter 返回分隔符
+func (o *CSVOptions) delimiter() rune {
+	if o.Delimiter == 0 {
+		return ','
+	}
+	return o.Delimiter
+}
+
+// WriteCSV 将 DataFrame 写入 CSV
+// 日期列写为 YYYYMMDD，浮点数不使用科学计数法，空值写为 NullToken
+func (df *DataFrame) WriteCSV(w io.Writer, opts *CSVOptions) error {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	if opts.BOM {
+		if _, err := w.Write(utf8BOM); err != nil {
+			return err
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = opts.delimiter()
+
+	if !opts.NoHeader {
+		header := df.Columns
+		if opts.TypedHeader {
+			header = make([]string, len(df.series))
+			for j, s := range df.series {
+				header[j] = s.name + ":" + s.typ.String()
+			}
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(df.series))
+	for i := 0; i < df.nrows; i++ {
+		for j, s := range df.series {
+			if s.nulls[i] {
+				record[j] = opts.NullToken
+			} else {
+				record[j] = formatCell(s, i)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatCell 以文本形式返回第 i 个非空元素，浮点数不使用科学计数法
+func formatCell(s *Series, i int) string {
+	if s.typ == ColumnFloat64 {
+		return strconv.FormatFloat(s.floats[i], 'f', -1, 64)
+	}
+	return s.String(i)
+}
+
+// ReadCSV 从 CSV 读取 DataFrame
+//
+// 列类型依次取自 Schema、表头中的类型（TypedHeader），其余列按以下规则推断：
+//   - 列名为 symbol、code 或以 _code 结尾时推断为字符串（如 600000 这类纯数字代码）
+//   - 全部为数值时推断为 float64（以 0 开头的整数如 "000001" 视为字符串，保留前导零）
+//   - 列名以 _date 结尾且全部为 YYYYMMDD 字符串时推断为日期
+//   - 其余情况推断为字符串
+//
+// 与 NullToken 相同的单元格记为空值。
+func ReadCSV(r io.Reader, opts *CSVOptions) (*DataFrame, error) {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	br := bufio.NewReader(r)
+	if head, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(head, utf8BOM) {
+		br.Discard(len(utf8BOM))
+	}
+
+	cr := csv.NewReader(br)
+	cr.Comma = opts.delimiter()
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read csv failed: %w", err)
+	}
+
+	columns := opts.Columns
+	if !opts.NoHeader {
+		if len(rows) == 0 {
+			return nil, fmt.Errorf("read csv failed: missing header")
+		}
+		if len(columns) == 0 {
+			columns = rows[0]
+		}
+		rows = rows[1:]
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("read csv failed: column names are required when there is no header")
+	}
+	headerTypes := make(Schema)
+	if opts.TypedHeader && !opts.NoHeader && len(opts.Columns) == 0 {
+		columns = append([]string(nil), columns...)
+		for j, column := range columns {
+			name, typeName, ok := cutLast(column, ":")
+			if !ok {
+				continue
+			}
+			typ, ok := parseColumnType(typeName)
+			if !ok {
+				return nil, fmt.Errorf("read csv failed: unknown type %q in header %q", typeName, column)
+			}
+			columns[j] = name
+			headerTypes[name] = typ
+		}
+	}
+	if len(rows) > 0 && len(rows[0]) != len(columns) {
+		return nil, fmt.Errorf("read csv failed: %d columns in data, %d column names", len(rows[0]), len(columns))
+	}
+
+	series := make([]*Series, len(columns))
+	for j, name := range columns {
+		cell := func(i int) (string, bool) {
+			v := rows[i][j]
+			return v, v != opts.NullToken
+		}
+
+		typ, ok := opts.Schema[name]
+		if !ok {
+			typ, ok = headerTypes[name]
+		}
+		if !ok && isCodeColumnName(name) {
+			typ, ok = ColumnString, true
+		}
+		if !ok {
+			typ = inferColumnType(name, len(rows), func(i int) interface{} {
+				v, ok := cell(i)
+				if !ok {
+					return nil
+				}
+				if isDateColumnName(name) && isDateString(v) {
+					return v
+				}
+				if f, ok := parseCSVNumber(v); ok {
+					return f
+				}
+				return v
+			})
+		}
+
+		s := newSeries(name, typ, len(rows))
+		for i := range rows {
+			if v, ok := cell(i); ok {
+				s.appendValue(v)
+			} else {
+				s.appendNull()
+			}
+		}
+		series[j] = s
+	}
+	return NewDataFrameFromSeries(series...)
+}
+
+// parseCSVNumber 解析 CSV 中的数值，带前导零的整数（如股票代码 000001）不视为数值
+func parseCSVNumber(v string) (float64, bool) {
+	digits := strings.TrimLeft(v, "+-")
+	if len(digits) > 1 && digits[0] == '0' && digits[1] != '.' {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	return f, err == nil
+}
+
+// isCodeColumnName 判断是否为证券代码列，这类列即使全部为数字也按字符串读取
+func isCodeColumnName(name string) bool {
+	return name == "symbol" || name == "code" || strings.HasSuffix(name, "_code")
+}
+
+// parseColumnType 解析 ColumnType.String 返回的类型名称
+func parseColumnType(name string) (ColumnType, bool) {
+	for _, typ := range []ColumnType{ColumnString, ColumnFloat64, ColumnInt64, ColumnDate} {
+		if typ.String() == name {
+			return typ, true
+		}
+	}
+	return 0, false
+}
+
+// cutLast 在最后一个 sep 处切分 s
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// WriteItemsCSV 将结构体切片（如 []*market.DailyItem）写入 CSV，表头使用字段的 json 标签
+// nil 元素写为整行空值，nil 指针字段写为空值
+func WriteItemsCSV[T any](w io.Writer, items []T, opts *CSVOptions) error {
+	df, err := DataFrameFromStructs(items)
+	if err != nil {
+		return err
+	}
+	return df.WriteCSV(w, opts)
+}
@@ -0,0 +1,234 @@
+package tushare
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// parseDates 解析 YYYYMMDD 日期
+func parseDates(values ...string) []time.Time {
+	dates := make([]time.Time, len(values))
+	for i, v := range values {
+		dates[i], _ = parseDate(v)
+	}
+	return dates
+}
+
+func TestDataFrame_WriteCSV(t *testing.T) {
+	df := newTestFrame(t,
+		NewStringSeries("ts_code", []string{"000001.SZ", "600000.SH"}, nil),
+		NewStringSeries("name", []string{"平安银行", "浦发,银行"}, nil),
+		NewDateSeries("trade_date", parseDates("20240102", "20240103"), nil),
+		NewFloat64Series("amount", []float64{1234567.5, 0}, []bool{false, true}),
+		NewInt64Series("vol", []int64{100, 200}, nil),
+	)
+
+	var buf bytes.Buffer
+	if err := df.WriteCSV(&buf, nil); err != nil {
+		t.Fatalf("WriteCSV 失败: %v", err)
+	}
+	want := "ts_code,name,trade_date,amount,vol\n" +
+		"000001.SZ,平安银行,20240102,1234567.5,100\n" +
+		"600000.SH,\"浦发,银行\",20240103,,200\n"
+	if buf.String() != want {
+		t.Errorf("期望:\n%s\n但得到:\n%s", want, buf.String())
+	}
+
+	buf.Reset()
+	err := df.WriteCSV(&buf, &CSVOptions{Delimiter: '\t', NoHeader: true, NullToken: "NA", BOM: true})
+	if err != nil {
+		t.Fatalf("WriteCSV 失败: %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), utf8BOM) {
+		t.Error("期望以 UTF-8 BOM 开头")
+	}
+	want = "000001.SZ\t平安银行\t20240102\t1234567.5\t100\n" +
+		"600000.SH\t浦发,银行\t20240103\tNA\t200\n"
+	if got := string(bytes.TrimPrefix(buf.Bytes(), utf8BOM)); got != want {
+		t.Errorf("期望:\n%s\n但得到:\n%s", want, got)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	input := "\xEF\xBB\xBFts_code,symbol,trade_date,close,pe,note\n" +
+		"000001.SZ,000001,20240102,9.39,NA,平安\n" +
+		"600000.SH,600000,20240103,7.1,5.2,NA\n"
+
+	df, err := ReadCSV(strings.NewReader(input), &CSVOptions{NullToken: "NA"})
+	if err != nil {
+		t.Fatalf("ReadCSV 失败: %v", err)
+	}
+
+	// BOM 被跳过，第一列名不受影响
+	assertStrings(t, df.Columns, []string{"ts_code", "symbol", "trade_date", "close", "pe", "note"})
+
+	want := map[string]ColumnType{
+		"ts_code":    ColumnString,
+		"symbol":     ColumnString, // 前导零不能丢失
+		"trade_date": ColumnDate,
+		"close":      ColumnFloat64,
+		"pe":         ColumnFloat64,
+		"note":       ColumnString,
+	}
+	for col, typ := range want {
+		if got := df.Column(col).Type(); got != typ {
+			t.Errorf("期望 %s 列类型为 %s，但得到 %s", col, typ, got)
+		}
+	}
+	assertStrings(t, columnStrings(df, "symbol"), []string{"000001", "600000"})
+	if !df.Column("pe").IsNull(0) || !df.Column("note").IsNull(1) {
+		t.Error("期望 NA 被读取为空值")
+	}
+	if got := df.GetFloat64(1, "pe"); got != 5.2 {
+		t.Errorf("期望 pe 为 5.2，但得到 %f", got)
+	}
+}
+
+func TestReadCSV_Options(t *testing.T) {
+	input := "000001.SZ;10\n600000.SH;20\n"
+	df, err := ReadCSV(strings.NewReader(input), &CSVOptions{
+		Delimiter: ';',
+		NoHeader:  true,
+		Columns:   []string{"ts_code", "vol"},
+		Schema:    Schema{"vol": ColumnInt64},
+	})
+	if err != nil {
+		t.Fatalf("ReadCSV 失败: %v", err)
+	}
+	if df.Len() != 2 || df.Column("vol").Type() != ColumnInt64 {
+		t.Fatalf("期望 2 行 int64 的 vol 列，但得到 %d 行 %s", df.Len(), df.Column("vol").Type())
+	}
+	if df.GetInt(1, "vol") != 20 {
+		t.Errorf("期望 vol 为 20，但得到 %d", df.GetInt(1, "vol"))
+	}
+
+	if _, err := ReadCSV(strings.NewReader(input), &CSVOptions{Delimiter: ';', NoHeader: true}); err == nil {
+		t.Error("期望没有表头且未指定列名时返回错误")
+	}
+	if _, err := ReadCSV(strings.NewReader("a,b\n1,2,3\n"), nil); err == nil {
+		t.Error("期望列数不一致时返回错误")
+	}
+}
+
+func TestCSV_RoundTrip(t *testing.T) {
+	df := NewDataFrame(newDailyResponse([]string{"000001.SZ", "600000.SH"}, 5))
+
+	var buf bytes.Buffer
+	if err := df.WriteCSV(&buf, &CSVOptions{BOM: true}); err != nil {
+		t.Fatalf("WriteCSV 失败: %v", err)
+	}
+	loaded, err := ReadCSV(&buf, nil)
+	if err != nil {
+		t.Fatalf("ReadCSV 失败: %v", err)
+	}
+
+	assertStrings(t, loaded.Columns, df.Columns)
+	for col, typ := range df.Schema() {
+		if got := loaded.Column(col).Type(); got != typ {
+			t.Errorf("期望 %s 列类型为 %s，但得到 %s", col, typ, got)
+		}
+	}
+	for i := 0; i < df.Len(); i++ {
+		for _, col := range df.Columns {
+			if got, want := loaded.GetString(i, col), df.GetString(i, col); got != want {
+				t.Errorf("第 %d 行 %s 列期望 %s，但得到 %s", i, col, want, got)
+			}
+		}
+	}
+}
+
+func TestCSV_RoundTripTypes(t *testing.T) {
+	df := newTestFrame(t,
+		NewStringSeries("ts_code", []string{"600000.SH", "600036.SH"}, nil),
+		NewStringSeries("symbol", []string{"600000", "600036"}, nil),
+		NewStringSeries("list_no", []string{"1", "2"}, nil),
+		NewDateSeries("trade_date", parseDates("20240102", "20240103"), nil),
+		NewFloat64Series("close", []float64{7.1, 30}, nil),
+		NewInt64Series("vol", []int64{100, 200}, nil),
+	)
+
+	// 默认表头：纯数字的代码列仍为字符串
+	var buf bytes.Buffer
+	if err := df.WriteCSV(&buf, nil); err != nil {
+		t.Fatalf("WriteCSV 失败: %v", err)
+	}
+	loaded, err := ReadCSV(&buf, nil)
+	if err != nil {
+		t.Fatalf("ReadCSV 失败: %v", err)
+	}
+	if got := loaded.Column("symbol").Type(); got != ColumnString {
+		t.Errorf("期望 symbol 为字符串，但得到 %s", got)
+	}
+	assertStrings(t, columnStrings(loaded, "symbol"), []string{"600000", "600036"})
+
+	// 带类型的表头：所有列类型与写入前一致，Schema 优先
+	buf.Reset()
+	if err := df.WriteCSV(&buf, &CSVOptions{TypedHeader: true}); err != nil {
+		t.Fatalf("WriteCSV 失败: %v", err)
+	}
+	if header, _, _ := strings.Cut(buf.String(), "\n"); header != "ts_code:string,symbol:string,list_no:string,trade_date:date,close:float64,vol:int64" {
+		t.Errorf("表头不正确: %s", header)
+	}
+	loaded, err = ReadCSV(&buf, &CSVOptions{TypedHeader: true, Schema: Schema{"vol": ColumnFloat64}})
+	if err != nil {
+		t.Fatalf("ReadCSV 失败: %v", err)
+	}
+	assertStrings(t, loaded.Columns, df.Columns)
+	want := df.Schema()
+	want["vol"] = ColumnFloat64
+	for col, typ := range want {
+		if got := loaded.Column(col).Type(); got != typ {
+			t.Errorf("期望 %s 列类型为 %s，但得到 %s", col, typ, got)
+		}
+	}
+	assertStrings(t, columnStrings(loaded, "list_no"), []string{"1", "2"})
+
+	if _, err := ReadCSV(strings.NewReader("vol:int32\n1\n"), &CSVOptions{TypedHeader: true}); err == nil {
+		t.Error("期望未知类型返回错误")
+	}
+}
+
+func TestWriteItemsCSV(t *testing.T) {
+	type item struct {
+		TSCode string   `json:"ts_code"`
+		Close  float64  `json:"close"`
+		Vol    int      `json:"vol"`
+		PE     *float64 `json:"pe"`
+		Note   string   `json:"-"`
+		Name   string
+	}
+
+	pe := 5.2
+	items := []*item{
+		{TSCode: "000001.SZ", Close: 9.39, Vol: 100, PE: &pe, Note: "ignored", Name: "平安银行"},
+		nil,
+		{TSCode: "600000.SH", Close: 7.1, Vol: 200},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteItemsCSV(&buf, items, nil); err != nil {
+		t.Fatalf("WriteItemsCSV 失败: %v", err)
+	}
+	want := "ts_code,close,vol,pe,Name\n" +
+		"000001.SZ,9.39,100,5.2,平安银行\n" +
+		",,,,\n" +
+		"600000.SH,7.1,200,,\n"
+	if buf.String() != want {
+		t.Errorf("期望:\n%s\n但得到:\n%s", want, buf.String())
+	}
+
+	// 空切片也写出表头
+	buf.Reset()
+	if err := WriteItemsCSV(&buf, []item{}, nil); err != nil {
+		t.Fatalf("WriteItemsCSV 失败: %v", err)
+	}
+	if buf.String() != "ts_code,close,vol,pe,Name\n" {
+		t.Errorf("期望只有表头，但得到 %q", buf.String())
+	}
+
+	if err := WriteItemsCSV(&buf, []int{1}, nil); err == nil {
+		t.Error("期望非结构体切片返回错误")
+	}
+}
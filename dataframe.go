@@ -0,0 +1,178 @@
+package tushare
+
+import (
+	"fmt"
+)
+
+// DataFrame 列式存储的数据帧（类似 pandas DataFrame）
+//
+// 每列以类型化的 Series 存储（float64/int64/string/日期，并带空值掩码），
+// 相比按行存储 map 大幅减少内存占用，并保留列的类型信息。
+type DataFrame struct {
+	Columns []string // 列名，按响应字段顺序排列（只读）
+
+	series []*Series
+	index  map[string]int
+	nrows  int
+}
+
+// Schema 列类型定义，未定义的列根据数据自动推断
+type Schema map[string]ColumnType
+
+// NewDataFrame 从响应创建 DataFrame，列类型根据数据自动推断
+func NewDataFrame(resp *Response) *DataFrame {
+	return NewDataFrameWithSchema(resp, nil)
+}
+
+// NewDataFrameWithSchema 从响应创建 DataFrame，按 schema 指定列类型
+func NewDataFrameWithSchema(resp *Response, schema Schema) *DataFrame {
+	if resp == nil || resp.Data == nil {
+		return newDataFrame(nil)
+	}
+
+	fields := resp.Data.Fields
+	items := resp.Data.Items
+	series := make([]*Series, len(fields))
+	for j, field := range fields {
+		at := func(i int) interface{} {
+			if j < len(items[i]) {
+				return items[i][j]
+			}
+			return nil
+		}
+
+		typ, ok := schema[field]
+		if !ok {
+			typ = inferColumnType(field, len(items), at)
+		}
+
+		s := newSeries(field, typ, len(items))
+		for i := range items {
+			s.appendValue(at(i))
+		}
+		series[j] = s
+	}
+	return newDataFrame(series)
+}
+
+// NewDataFrameFromSeries 由多个等长的列创建 DataFrame
+func NewDataFrameFromSeries(series ...*Series) (*DataFrame, error) {
+	seen := make(map[string]bool, len(series))
+	for _, s := range series {
+		if seen[s.name] {
+			return nil, fmt.Errorf("duplicate column: %s", s.name)
+		}
+		seen[s.name] = true
+		if s.Len() != series[0].Len() {
+			return nil, fmt.Errorf("column %s has %d rows, expected %d", s.name, s.Len(), series[0].Len())
+		}
+	}
+	return newDataFrame(series), nil
+}
+
+// newDataFrame 由已校验的列创建 DataFrame
+func newDataFrame(series []*Series) *DataFrame {
+	df := &DataFrame{
+		Columns: make([]string, len(series)),
+		series:  series,
+		index:   make(map[string]int, len(series)),
+	}
+	for j, s := range series {
+		df.Columns[j] = s.name
+		df.index[s.name] = j
+	}
+	if len(series) > 0 {
+		df.nrows = series[0].Len()
+	}
+	return df
+}
+
+// Len 返回数据行数
+func (df *DataFrame) Len() int {
+	return df.nrows
+}
+
+// Column 返回指定列，列不存在时返回 nil
+func (df *DataFrame) Column(name string) *Series {
+	j, ok := df.index[name]
+	if !ok {
+		return nil
+	}
+	return df.series[j]
+}
+
+// Schema 返回各列的类型
+func (df *DataFrame) Schema() Schema {
+	schema := make(Schema, len(df.series))
+	for _, s := range df.series {
+		schema[s.name] = s.typ
+	}
+	return schema
+}
+
+// Get 获取指定行和列的值
+// 空值返回 (nil, true)；日期列返回 YYYYMMDD 字符串，与 Tushare 原始数据保持一致
+func (df *DataFrame) Get(row int, col string) (interface{}, bool) {
+	s := df.Column(col)
+	if s == nil || row < 0 || row >= df.nrows {
+		return nil, false
+	}
+	if s.typ == ColumnDate && !s.nulls[row] {
+		return s.String(row), true
+	}
+	return s.Value(row), true
+}
+
+// GetString 获取字符串值
+func (df *DataFrame) GetString(row int, col string) string {
+	s := df.Column(col)
+	if s == nil || row < 0 || row >= df.nrows {
+		return ""
+	}
+	return s.String(row)
+}
+
+// GetFloat64 获取 float64 值，空值或无法转换时返回 0
+// 日期列返回 YYYYMMDD 对应的数值（如 20240102）
+func (df *DataFrame) GetFloat64(row int, col string) float64 {
+	s := df.Column(col)
+	if s == nil || row < 0 || row >= df.nrows {
+		return 0
+	}
+	if s.typ == ColumnDate {
+		return float64(dateNumber(s, row))
+	}
+	f, _ := s.Float64(row)
+	return f
+}
+
+// GetInt 获取 int 值，日期列返回 YYYYMMDD 对应的整数
+func (df *DataFrame) GetInt(row int, col string) int {
+	s := df.Column(col)
+	if s != nil && s.typ == ColumnInt64 && row >= 0 && row < df.nrows {
+		return int(s.ints[row])
+	}
+	return int(df.GetFloat64(row, col))
+}
+
+// dateNumber 将日期列的第 row 个元素转换为 YYYYMMDD 数值，空值返回 0
+func dateNumber(s *Series, row int) int {
+	if s.nulls[row] {
+		return 0
+	}
+	d := s.dates[row]
+	return d.Year()*10000 + int(d.Month())*100 + d.Day()
+}
+
+// Records 将数据转换为记录列表（map 格式），取值规则与 Get 相同
+func (df *DataFrame) Records() []map[string]interface{} {
+	records := make([]map[string]interface{}, df.nrows)
+	for i := range records {
+		record := make(map[string]interface{}, len(df.series))
+		for _, s := range df.series {
+			record[s.name], _ = df.Get(i, s.name)
+		}
+		records[i] = record
+	}
+	return records
+}
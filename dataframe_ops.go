@@ -0,0 +1,370 @@
+package tushare
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrColumnNotFound 列不存在
+var ErrColumnNotFound = errors.New("column not found")
+
+// columnNotFound 返回包含列名的列不存在错误
+func columnNotFound(col string) error {
+	return fmt.Errorf("%w: %s", ErrColumnNotFound, col)
+}
+
+// columns 按列名查找列
+func (df *DataFrame) columns(cols []string) ([]*Series, error) {
+	result := make([]*Series, len(cols))
+	for i, col := range cols {
+		s := df.Column(col)
+		if s == nil {
+			return nil, columnNotFound(col)
+		}
+		result[i] = s
+	}
+	return result, nil
+}
+
+// take 按行号取出数据组成新的 DataFrame，行号为负表示整行为空值
+func (df *DataFrame) take(idx []int) *DataFrame {
+	series := make([]*Series, len(df.series))
+	for j, s := range df.series {
+		series[j] = s.take(idx)
+	}
+	result := newDataFrame(series)
+	result.nrows = len(idx)
+	return result
+}
+
+// ==================== 行视图 ====================
+
+// Row DataFrame 的行视图，用于 Filter 等逐行操作
+type Row struct {
+	df    *DataFrame
+	index int
+}
+
+// Row 返回第 i 行的视图
+func (df *DataFrame) Row(i int) Row {
+	return Row{df: df, index: i}
+}
+
+// Index 行号
+func (r Row) Index() int {
+	return r.index
+}
+
+// Get 获取指定列的值，规则与 DataFrame.Get 相同
+func (r Row) Get(col string) (interface{}, bool) {
+	return r.df.Get(r.index, col)
+}
+
+// GetString 获取字符串值
+func (r Row) GetString(col string) string {
+	return r.df.GetString(r.index, col)
+}
+
+// GetFloat64 获取 float64 值
+func (r Row) GetFloat64(col string) float64 {
+	return r.df.GetFloat64(r.index, col)
+}
+
+// GetInt 获取 int 值
+func (r Row) GetInt(col string) int {
+	return r.df.GetInt(r.index, col)
+}
+
+// IsNull 判断指定列是否为空值，列不存在时返回 true
+func (r Row) IsNull(col string) bool {
+	s := r.df.Column(col)
+	return s == nil || s.IsNull(r.index)
+}
+
+// ==================== 选择与过滤 ====================
+
+// Select 选择指定列，按参数顺序返回新的 DataFrame
+func (df *DataFrame) Select(cols ...string) (*DataFrame, error) {
+	series, err := df.columns(cols)
+	if err != nil {
+		return nil, err
+	}
+	return NewDataFrameFromSeries(series...)
+}
+
+// Drop 删除指定列，返回新的 DataFrame
+func (df *DataFrame) Drop(cols ...string) (*DataFrame, error) {
+	if _, err := df.columns(cols); err != nil {
+		return nil, err
+	}
+
+	dropped := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		dropped[col] = true
+	}
+
+	series := make([]*Series, 0, len(df.series))
+	for _, s := range df.series {
+		if !dropped[s.name] {
+			series = append(series, s)
+		}
+	}
+	result := newDataFrame(series)
+	result.nrows = df.nrows
+	return result, nil
+}
+
+// Filter 保留 fn 返回 true 的行
+func (df *DataFrame) Filter(fn func(row Row) bool) *DataFrame {
+	idx := make([]int, 0, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		if fn(Row{df: df, index: i}) {
+			idx = append(idx, i)
+		}
+	}
+	return df.take(idx)
+}
+
+// Head 返回前 n 行
+func (df *DataFrame) Head(n int) *DataFrame {
+	n = clampRows(n, df.nrows)
+	return df.take(rowRange(0, n))
+}
+
+// Tail 返回后 n 行
+func (df *DataFrame) Tail(n int) *DataFrame {
+	n = clampRows(n, df.nrows)
+	return df.take(rowRange(df.nrows-n, df.nrows))
+}
+
+// clampRows 将行数限制在 [0, total] 之间
+func clampRows(n, total int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > total {
+		return total
+	}
+	return n
+}
+
+// rowRange 返回 [start, end) 的行号
+func rowRange(start, end int) []int {
+	idx := make([]int, end-start)
+	for i := range idx {
+		idx[i] = start + i
+	}
+	return idx
+}
+
+// Op 比较运算符
+type Op string
+
+const (
+	// OpEq 等于
+	OpEq Op = "=="
+	// OpNe 不等于
+	OpNe Op = "!="
+	// OpGt 大于
+	OpGt Op = ">"
+	// OpGe 大于等于
+	OpGe Op = ">="
+	// OpLt 小于
+	OpLt Op = "<"
+	// OpLe 小于等于
+	OpLe Op = "<="
+	// OpIn 属于集合（value 为切片）
+	OpIn Op = "in"
+	// OpNotIn 不属于集合（value 为切片）
+	OpNotIn Op = "not in"
+)
+
+// Where 按条件过滤行，value 会转换为列的类型后比较
+// 空值不满足任何条件；OpIn/OpNotIn 的 value 需为切片
+func (df *DataFrame) Where(col string, op Op, value interface{}) (*DataFrame, error) {
+	s := df.Column(col)
+	if s == nil {
+		return nil, columnNotFound(col)
+	}
+
+	var match func(i int) bool
+	switch op {
+	case OpIn, OpNotIn:
+		values, ok := toValueList(value)
+		if !ok {
+			return nil, fmt.Errorf("operator %q requires a slice value, got %T", op, value)
+		}
+		targets := make([]comparand, 0, len(values))
+		for _, v := range values {
+			if c, ok := newComparand(s.typ, v); ok {
+				targets = append(targets, c)
+			}
+		}
+		match = func(i int) bool {
+			found := false
+			for _, c := range targets {
+				if c.compare(s, i) == 0 {
+					found = true
+					break
+				}
+			}
+			return found == (op == OpIn)
+		}
+	case OpEq, OpNe, OpGt, OpGe, OpLt, OpLe:
+		c, ok := newComparand(s.typ, value)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare column %s (%s) with %v", col, s.typ, value)
+		}
+		match = func(i int) bool {
+			return op.test(c.compare(s, i))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported operator: %q", op)
+	}
+
+	idx := make([]int, 0, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		if !s.nulls[i] && match(i) {
+			idx = append(idx, i)
+		}
+	}
+	return df.take(idx), nil
+}
+
+// test 根据比较结果（列值相对于目标值）判断是否满足运算符
+func (op Op) test(cmp int) bool {
+	switch op {
+	case OpEq:
+		return cmp == 0
+	case OpNe:
+		return cmp != 0
+	case OpGt:
+		return cmp > 0
+	case OpGe:
+		return cmp >= 0
+	case OpLt:
+		return cmp < 0
+	case OpLe:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// comparand 转换为列类型的比较目标值
+type comparand struct {
+	f float64
+	s string
+	d time.Time
+}
+
+// newComparand 将 value 转换为与列类型可比较的值
+func newComparand(typ ColumnType, value interface{}) (comparand, bool) {
+	var c comparand
+	var ok bool
+	switch typ {
+	case ColumnFloat64, ColumnInt64:
+		c.f, ok = toFloat64(value)
+	case ColumnDate:
+		c.d, ok = toDate(value)
+	default:
+		c.s, ok = toString(value), value != nil
+	}
+	return c, ok
+}
+
+// compare 比较列中第 i 个非空元素与目标值
+func (c comparand) compare(s *Series, i int) int {
+	switch s.typ {
+	case ColumnFloat64:
+		return compareFloat64(s.floats[i], c.f)
+	case ColumnInt64:
+		return compareFloat64(float64(s.ints[i]), c.f)
+	case ColumnDate:
+		return s.dates[i].Compare(c.d)
+	default:
+		return strings.Compare(s.strs[i], c.s)
+	}
+}
+
+// toValueList 将切片转换为 []interface{}
+func toValueList(value interface{}) ([]interface{}, bool) {
+	if values, ok := value.([]interface{}); ok {
+		return values, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	return values, true
+}
+
+// ==================== 排序与去重 ====================
+
+// SortBy 按多列稳定排序，ascending 与 cols 一一对应，
+// 只传一个值时作用于所有列，不传时默认升序；空值始终排在最后
+func (df *DataFrame) SortBy(cols []string, ascending ...bool) (*DataFrame, error) {
+	series, err := df.columns(cols)
+	if err != nil {
+		return nil, err
+	}
+
+	asc := make([]bool, len(cols))
+	for k := range asc {
+		switch {
+		case len(ascending) == 0:
+			asc[k] = true
+		case len(ascending) == 1:
+			asc[k] = ascending[0]
+		case k < len(ascending):
+			asc[k] = ascending[k]
+		default:
+			asc[k] = true
+		}
+	}
+
+	idx := rowRange(0, df.nrows)
+	sort.SliceStable(idx, func(a, b int) bool {
+		i, j := idx[a], idx[b]
+		for k, s := range series {
+			cmp := s.compare(i, j)
+			if cmp == 0 {
+				continue
+			}
+			if !asc[k] && !s.nulls[i] && !s.nulls[j] {
+				cmp = -cmp
+			}
+			return cmp < 0
+		}
+		return false
+	})
+	return df.take(idx), nil
+}
+
+// Unique 返回指定列的去重值，按首次出现的顺序排列（空值最多保留一个）
+func (df *DataFrame) Unique(col string) (*Series, error) {
+	s := df.Column(col)
+	if s == nil {
+		return nil, columnNotFound(col)
+	}
+
+	seen := make(map[string]bool)
+	idx := make([]int, 0)
+	for i := 0; i < s.Len(); i++ {
+		key := s.key(i)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		idx = append(idx, i)
+	}
+	return s.take(idx), nil
+}
@@ -0,0 +1,157 @@
+package tushare
+
+import (
+	"errors"
+	"testing"
+)
+
+// newBasicFrame 构造用于测试的每日指标 DataFrame
+func newBasicFrame() *DataFrame {
+	return NewDataFrame(&Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "trade_date", "industry", "pe", "total_mv"},
+			Items: [][]interface{}{
+				{"000001.SZ", "20240102", "银行", 4.5, 2000.0},
+				{"600036.SH", "20240102", "银行", 5.8, 8000.0},
+				{"000002.SZ", "20240102", "全国地产", nil, 1000.0},
+				{"600519.SH", "20240103", "白酒", 28.1, 21000.0},
+				{"000858.SZ", "20240103", "白酒", 18.3, 5000.0},
+			},
+		},
+	})
+}
+
+// columnStrings 返回列的字符串形式，便于断言
+func columnStrings(df *DataFrame, col string) []string {
+	values := make([]string, df.Len())
+	for i := range values {
+		values[i] = df.GetString(i, col)
+	}
+	return values
+}
+
+func assertStrings(t *testing.T, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("期望 %v，但得到 %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("期望 %v，但得到 %v", want, got)
+		}
+	}
+}
+
+func TestDataFrame_SelectDrop(t *testing.T) {
+	df := newBasicFrame()
+
+	selected, err := df.Select("pe", "ts_code")
+	if err != nil {
+		t.Fatalf("Select 失败: %v", err)
+	}
+	assertStrings(t, selected.Columns, []string{"pe", "ts_code"})
+	if selected.Len() != 5 {
+		t.Errorf("期望 5 行，但得到 %d", selected.Len())
+	}
+
+	dropped, err := df.Drop("industry", "total_mv")
+	if err != nil {
+		t.Fatalf("Drop 失败: %v", err)
+	}
+	assertStrings(t, dropped.Columns, []string{"ts_code", "trade_date", "pe"})
+
+	if _, err := df.Select("missing"); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望返回 ErrColumnNotFound，但得到 %v", err)
+	}
+
+	// 原 DataFrame 不受影响
+	if len(df.Columns) != 5 {
+		t.Errorf("原 DataFrame 不应被修改，但列数变为 %d", len(df.Columns))
+	}
+}
+
+func TestDataFrame_Filter(t *testing.T) {
+	df := newBasicFrame()
+
+	result := df.Filter(func(row Row) bool {
+		return !row.IsNull("pe") && row.GetFloat64("pe") < 10
+	})
+	assertStrings(t, columnStrings(result, "ts_code"), []string{"000001.SZ", "600036.SH"})
+}
+
+func TestDataFrame_Where(t *testing.T) {
+	df := newBasicFrame()
+
+	cases := []struct {
+		col   string
+		op    Op
+		value interface{}
+		want  []string
+	}{
+		{"pe", OpGt, 10, []string{"600519.SH", "000858.SZ"}},
+		{"pe", OpLe, 5.8, []string{"000001.SZ", "600036.SH"}},
+		{"pe", OpNe, 4.5, []string{"600036.SH", "600519.SH", "000858.SZ"}},
+		{"industry", OpEq, "白酒", []string{"600519.SH", "000858.SZ"}},
+		{"trade_date", OpGe, "20240103", []string{"600519.SH", "000858.SZ"}},
+		{"ts_code", OpIn, []string{"000001.SZ", "000002.SZ"}, []string{"000001.SZ", "000002.SZ"}},
+		{"industry", OpNotIn, []string{"银行", "白酒"}, []string{"000002.SZ"}},
+	}
+	for _, c := range cases {
+		result, err := df.Where(c.col, c.op, c.value)
+		if err != nil {
+			t.Fatalf("Where(%s %s %v) 失败: %v", c.col, c.op, c.value, err)
+		}
+		assertStrings(t, columnStrings(result, "ts_code"), c.want)
+	}
+
+	if _, err := df.Where("pe", OpIn, 1.0); err == nil {
+		t.Error("OpIn 的值不是切片时应返回错误")
+	}
+	if _, err := df.Where("pe", "~", 1.0); err == nil {
+		t.Error("不支持的运算符应返回错误")
+	}
+}
+
+func TestDataFrame_SortBy(t *testing.T) {
+	df := newBasicFrame()
+
+	sorted, err := df.SortBy([]string{"pe"})
+	if err != nil {
+		t.Fatalf("SortBy 失败: %v", err)
+	}
+	// 空值排在最后
+	assertStrings(t, columnStrings(sorted, "ts_code"), []string{"000001.SZ", "600036.SH", "000858.SZ", "600519.SH", "000002.SZ"})
+
+	sorted, err = df.SortBy([]string{"trade_date", "total_mv"}, true, false)
+	if err != nil {
+		t.Fatalf("SortBy 失败: %v", err)
+	}
+	assertStrings(t, columnStrings(sorted, "ts_code"), []string{"600036.SH", "000001.SZ", "000002.SZ", "600519.SH", "000858.SZ"})
+
+	sorted, err = df.SortBy([]string{"pe"}, false)
+	if err != nil {
+		t.Fatalf("SortBy 失败: %v", err)
+	}
+	assertStrings(t, columnStrings(sorted, "ts_code"), []string{"600519.SH", "000858.SZ", "600036.SH", "000001.SZ", "000002.SZ"})
+
+	// 原 DataFrame 顺序不变
+	if df.GetString(0, "ts_code") != "000001.SZ" {
+		t.Error("原 DataFrame 不应被修改")
+	}
+}
+
+func TestDataFrame_HeadTailUnique(t *testing.T) {
+	df := newBasicFrame()
+
+	assertStrings(t, columnStrings(df.Head(2), "ts_code"), []string{"000001.SZ", "600036.SH"})
+	assertStrings(t, columnStrings(df.Tail(2), "ts_code"), []string{"600519.SH", "000858.SZ"})
+	if df.Head(10).Len() != 5 || df.Tail(-1).Len() != 0 {
+		t.Error("Head/Tail 超出范围时应截断")
+	}
+
+	unique, err := df.Unique("industry")
+	if err != nil {
+		t.Fatalf("Unique 失败: %v", err)
+	}
+	assertStrings(t, unique.Strings(), []string{"银行", "全国地产", "白酒"})
+}
@@ -0,0 +1,217 @@
+package tushare
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// newDailyResponse 构造模拟的日线行情响应（trade_date 降序，与 Tushare 返回顺序一致）
+func newDailyResponse(codes []string, days int) *Response {
+	fields := []string{"ts_code", "trade_date", "open", "high", "low", "close", "pre_close", "change", "pct_chg", "vol", "amount"}
+	items := make([][]interface{}, 0, len(codes)*days)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, code := range codes {
+		for d := days - 1; d >= 0; d-- {
+			price := 10 + float64(d%50)/10
+			items = append(items, []interface{}{
+				code,
+				start.AddDate(0, 0, d).Format(DateLayout),
+				price, price + 0.2, price - 0.1, price + 0.1, price, 0.1, 1.0,
+				100000.0 + float64(d), 1000000.0 + float64(d),
+			})
+		}
+	}
+	return &Response{Data: &ResponseData{Fields: fields, Items: items}}
+}
+
+// newTestFrame 由 Series 构造测试用的 DataFrame，构造失败时立即终止测试
+func newTestFrame(t *testing.T, series ...*Series) *DataFrame {
+	t.Helper()
+	df, err := NewDataFrameFromSeries(series...)
+	if err != nil {
+		t.Fatalf("构造 DataFrame 失败: %v", err)
+	}
+	return df
+}
+
+func TestDataFrame_TypeInference(t *testing.T) {
+	resp := &Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "trade_date", "close", "pe", "count", "symbol"},
+			Items: [][]interface{}{
+				{"000001.SZ", "20240102", 9.39, nil, 3, "000001"},
+				{"000002.SZ", "20240102", 10.5, 8.2, 4, "000002"},
+			},
+		},
+	}
+
+	df := NewDataFrame(resp)
+	want := map[string]ColumnType{
+		"ts_code":    ColumnString,
+		"trade_date": ColumnDate,
+		"close":      ColumnFloat64,
+		"pe":         ColumnFloat64,
+		"count":      ColumnInt64,
+		"symbol":     ColumnString,
+	}
+	for col, typ := range want {
+		if got := df.Column(col).Type(); got != typ {
+			t.Errorf("期望 %s 列类型为 %s，但得到 %s", col, typ, got)
+		}
+	}
+
+	// 空值
+	if !df.Column("pe").IsNull(0) {
+		t.Error("期望 pe 第 0 行为空值")
+	}
+	if v, ok := df.Get(0, "pe"); !ok || v != nil {
+		t.Errorf("期望空值返回 (nil, true)，但得到 (%v, %v)", v, ok)
+	}
+	if df.GetFloat64(0, "pe") != 0 {
+		t.Error("期望空值的 GetFloat64 返回 0")
+	}
+
+	// 日期列保持原始字符串格式
+	if v, _ := df.Get(0, "trade_date"); v != "20240102" {
+		t.Errorf("期望日期列返回 20240102，但得到 %v", v)
+	}
+	if d := df.Column("trade_date").Dates()[0]; !d.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("期望解析日期为 2024-01-02，但得到 %v", d)
+	}
+
+	// 类型化列访问
+	closes := df.Column("close").Float64s()
+	if len(closes) != 2 || closes[1] != 10.5 {
+		t.Errorf("期望 close 列为 [9.39 10.5]，但得到 %v", closes)
+	}
+	if df.GetInt(1, "count") != 4 {
+		t.Errorf("期望 count 为 4，但得到 %d", df.GetInt(1, "count"))
+	}
+	if df.GetString(0, "close") != "9.39" {
+		t.Errorf("期望 close 字符串为 9.39，但得到 %s", df.GetString(0, "close"))
+	}
+}
+
+func TestDataFrame_GetDateAsNumber(t *testing.T) {
+	resp := &Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "trade_date"},
+			Items: [][]interface{}{
+				{"000001.SZ", "20240102"},
+				{"000002.SZ", nil},
+			},
+		},
+	}
+
+	// 日期列与改为列式存储前一样按 YYYYMMDD 数值读取
+	df := NewDataFrame(resp)
+	if df.Column("trade_date").Type() != ColumnDate {
+		t.Fatalf("期望 trade_date 为日期列，但得到 %s", df.Column("trade_date").Type())
+	}
+	if got := df.GetInt(0, "trade_date"); got != 20240102 {
+		t.Errorf("期望 GetInt 返回 20240102，但得到 %d", got)
+	}
+	if got := df.Row(0).GetFloat64("trade_date"); got != 20240102 {
+		t.Errorf("期望 GetFloat64 返回 20240102，但得到 %v", got)
+	}
+	if got := df.GetInt(1, "trade_date"); got != 0 {
+		t.Errorf("期望空值返回 0，但得到 %d", got)
+	}
+}
+
+func TestDataFrame_Schema(t *testing.T) {
+	resp := &Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "vol", "list_date"},
+			Items: [][]interface{}{
+				{"000001.SZ", "1200", "20240102"},
+				{"000002.SZ", "bad", nil},
+			},
+		},
+	}
+
+	df := NewDataFrameWithSchema(resp, Schema{"vol": ColumnInt64, "list_date": ColumnString})
+	if df.Column("vol").Type() != ColumnInt64 {
+		t.Errorf("期望 vol 列类型为 int64，但得到 %s", df.Column("vol").Type())
+	}
+	if df.Column("vol").Int64s()[0] != 1200 {
+		t.Errorf("期望 vol 为 1200，但得到 %d", df.Column("vol").Int64s()[0])
+	}
+	if !df.Column("vol").IsNull(1) {
+		t.Error("无法转换的值应记为空值")
+	}
+	if df.Column("list_date").Type() != ColumnString {
+		t.Errorf("期望 list_date 列类型为 string，但得到 %s", df.Column("list_date").Type())
+	}
+	if df.Column("missing") != nil {
+		t.Error("不存在的列应返回 nil")
+	}
+}
+
+func TestDataFrame_StringToFloat(t *testing.T) {
+	resp := &Response{
+		Data: &ResponseData{
+			Fields: []string{"value"},
+			Items:  [][]interface{}{{"1.25"}, {"abc"}},
+		},
+	}
+
+	df := NewDataFrame(resp)
+	if df.GetFloat64(0, "value") != 1.25 {
+		t.Errorf("期望 1.25，但得到 %f", df.GetFloat64(0, "value"))
+	}
+	if df.GetFloat64(1, "value") != 0 {
+		t.Errorf("无法解析的字符串应返回 0，但得到 %f", df.GetFloat64(1, "value"))
+	}
+}
+
+func TestNewDataFrameFromSeries(t *testing.T) {
+	df, err := NewDataFrameFromSeries(
+		NewStringSeries("ts_code", []string{"000001.SZ", "000002.SZ"}, nil),
+		NewFloat64Series("close", []float64{9.39, 0}, []bool{false, true}),
+	)
+	if err != nil {
+		t.Fatalf("创建失败: %v", err)
+	}
+	if df.Len() != 2 || len(df.Columns) != 2 {
+		t.Errorf("期望 2 行 2 列，但得到 %d 行 %d 列", df.Len(), len(df.Columns))
+	}
+	if v, _ := df.Get(1, "close"); v != nil {
+		t.Errorf("期望空值，但得到 %v", v)
+	}
+
+	if _, err := NewDataFrameFromSeries(
+		NewStringSeries("a", []string{"x"}, nil),
+		NewStringSeries("b", []string{"x", "y"}, nil),
+	); err == nil {
+		t.Error("期望长度不一致时返回错误")
+	}
+}
+
+// 对比按行存储 map 与列式存储在 10 万行日线数据上的内存占用
+func BenchmarkDataFrame_Daily100k_Records(b *testing.B) {
+	resp := newBenchmarkDailyResponse()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = resp.ToRecords()
+	}
+}
+
+func BenchmarkDataFrame_Daily100k_Columnar(b *testing.B) {
+	resp := newBenchmarkDailyResponse()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = NewDataFrame(resp)
+	}
+}
+
+func newBenchmarkDailyResponse() *Response {
+	codes := make([]string, 400)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("%06d.SZ", i+1)
+	}
+	return newDailyResponse(codes, 250)
+}
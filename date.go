@@ -0,0 +1,136 @@
+package tushare
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Date Tushare 日期（YYYYMMDD），可直接用于响应项的日期字段
+//
+// JSON 编解码为 "YYYYMMDD" 字符串，null 和空字符串解码为零值，零值编码为 null。
+// 在 DataFrameFromStructs 和 ToStruct 中对应日期列，零值对应空值。
+type Date struct {
+	time.Time
+}
+
+// ParseDate 解析 YYYYMMDD 或 YYYY-MM-DD 格式的日期，空字符串返回零值
+func ParseDate(s string) (Date, error) {
+	if s == "" {
+		return Date{}, nil
+	}
+	t, ok := parseDate(s)
+	if !ok {
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+	return Date{Time: t}, nil
+}
+
+// NewDate 返回指定年月日的日期（UTC）
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// String 返回 YYYYMMDD 格式的日期，零值返回空字符串
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Format(DateLayout)
+}
+
+// MarshalJSON 实现 json.Marshaler
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format(DateLayout))
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler，同时接受 YYYYMMDD 格式的数字
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(data)
+	}
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// DateTimeLayout Tushare 日期时间格式（北京时间），如分钟行情的 trade_time
+const DateTimeLayout = "2006-01-02 15:04:05"
+
+// Shanghai 北京时间时区，Tushare 的日期时间均为北京时间；系统缺少时区数据时使用固定的 UTC+8
+var Shanghai = func() *time.Location {
+	if loc, err := time.LoadLocation("Asia/Shanghai"); err == nil {
+		return loc
+	}
+	return time.FixedZone("CST", 8*60*60)
+}()
+
+// DateTime Tushare 日期时间（北京时间，精确到秒），可直接用于响应项的时间字段
+//
+// JSON 编解码为 "2006-01-02 15:04:05" 格式的字符串，null 和空字符串解码为零值，零值编码为 null。
+// 在 DataFrameFromStructs 中对应字符串列以保留时分秒，零值对应空值；ToStruct 时按相同格式解析。
+type DateTime struct {
+	time.Time
+}
+
+// ParseDateTime 按北京时间解析 DateTimeLayout 格式的日期时间，空字符串返回零值
+func ParseDateTime(s string) (DateTime, error) {
+	if s == "" {
+		return DateTime{}, nil
+	}
+	t, err := time.ParseInLocation(DateTimeLayout, s, Shanghai)
+	if err != nil {
+		return DateTime{}, fmt.Errorf("invalid datetime %q", s)
+	}
+	return DateTime{Time: t}, nil
+}
+
+// String 返回北京时间的 DateTimeLayout 格式，零值返回空字符串
+func (d DateTime) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.In(Shanghai).Format(DateTimeLayout)
+}
+
+// MarshalJSON 实现 json.Marshaler
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = DateTime{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseDateTime(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
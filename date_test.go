@@ -0,0 +1,150 @@
+package tushare
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDate_JSON(t *testing.T) {
+	var item struct {
+		TradeDate Date `json:"trade_date"`
+		EndDate   Date `json:"end_date"`
+		AnnDate   Date `json:"ann_date"`
+		ListDate  Date `json:"list_date"`
+	}
+	data := `{"trade_date":"20240102","end_date":null,"ann_date":"","list_date":19910403}`
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatalf("Unmarshal 失败: %v", err)
+	}
+	if !item.TradeDate.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("trade_date 解析不正确: %v", item.TradeDate)
+	}
+	if !item.EndDate.IsZero() || !item.AnnDate.IsZero() {
+		t.Error("期望 null 和空字符串解码为零值")
+	}
+	if item.ListDate.String() != "19910403" {
+		t.Errorf("期望数字日期解码为 19910403，但得到 %s", item.ListDate)
+	}
+
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal 失败: %v", err)
+	}
+	want := `{"trade_date":"20240102","end_date":null,"ann_date":null,"list_date":"19910403"}`
+	if string(out) != want {
+		t.Errorf("期望 %s，但得到 %s", want, out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"trade_date":"2024-13-01"}`), &item); err == nil {
+		t.Error("期望无效日期返回错误")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2024-01-02")
+	if err != nil || d != NewDate(2024, time.January, 2) {
+		t.Errorf("期望 20240102，但得到 %v（%v）", d, err)
+	}
+	if d, err := ParseDate(""); err != nil || !d.IsZero() {
+		t.Errorf("期望空字符串返回零值，但得到 %v（%v）", d, err)
+	}
+	if _, err := ParseDate("abc"); err == nil {
+		t.Error("期望无效日期返回错误")
+	}
+}
+
+func TestDate_Structs(t *testing.T) {
+	type item struct {
+		TSCode    string `json:"ts_code"`
+		TradeDate Date   `json:"trade_date"`
+	}
+	items := []item{
+		{TSCode: "000001.SZ", TradeDate: NewDate(2024, time.January, 2)},
+		{TSCode: "600000.SH"},
+	}
+
+	df, err := DataFrameFromStructs(items)
+	if err != nil {
+		t.Fatalf("DataFrameFromStructs 失败: %v", err)
+	}
+	if df.Column("trade_date").Type() != ColumnDate {
+		t.Errorf("期望 Date 字段为日期列，但得到 %s", df.Column("trade_date").Type())
+	}
+	if df.GetString(0, "trade_date") != "20240102" || !df.IsNull(1, "trade_date") {
+		t.Error("期望零值 Date 为空值")
+	}
+
+	var back []item
+	if err := df.ToStruct(&back); err != nil {
+		t.Fatalf("ToStruct 失败: %v", err)
+	}
+	if back[0].TradeDate != items[0].TradeDate || !back[1].TradeDate.IsZero() {
+		t.Errorf("ToStruct 结果不正确: %v", back)
+	}
+}
+
+func TestDateTime_JSON(t *testing.T) {
+	var item struct {
+		TradeTime DateTime `json:"trade_time"`
+		LastTime  DateTime `json:"last_time"`
+	}
+	data := `{"trade_time":"2024-01-02 09:31:00","last_time":null}`
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatalf("Unmarshal 失败: %v", err)
+	}
+	if want := time.Date(2024, 1, 2, 1, 31, 0, 0, time.UTC); !item.TradeTime.Equal(want) {
+		t.Errorf("期望按北京时间解析为 %v，但得到 %v", want, item.TradeTime.UTC())
+	}
+	if !item.LastTime.IsZero() {
+		t.Error("期望 null 解码为零值")
+	}
+
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal 失败: %v", err)
+	}
+	if want := `{"trade_time":"2024-01-02 09:31:00","last_time":null}`; string(out) != want {
+		t.Errorf("期望 %s，但得到 %s", want, out)
+	}
+
+	// 其他时区的时间按北京时间输出
+	utc := DateTime{Time: time.Date(2024, 1, 2, 1, 31, 0, 0, time.UTC)}
+	if utc.String() != "2024-01-02 09:31:00" {
+		t.Errorf("期望 2024-01-02 09:31:00，但得到 %s", utc)
+	}
+	if _, err := ParseDateTime("20240102"); err == nil {
+		t.Error("期望无效日期时间返回错误")
+	}
+}
+
+func TestDateTime_Structs(t *testing.T) {
+	type item struct {
+		TSCode    string   `json:"ts_code"`
+		TradeTime DateTime `json:"trade_time"`
+	}
+	tradeTime, _ := ParseDateTime("2024-01-02 09:31:00")
+	items := []item{
+		{TSCode: "000001.SZ", TradeTime: tradeTime},
+		{TSCode: "600000.SH"},
+	}
+
+	df, err := DataFrameFromStructs(items)
+	if err != nil {
+		t.Fatalf("DataFrameFromStructs 失败: %v", err)
+	}
+	if df.Column("trade_time").Type() != ColumnString {
+		t.Errorf("期望 DateTime 字段为字符串列，但得到 %s", df.Column("trade_time").Type())
+	}
+	if df.GetString(0, "trade_time") != "2024-01-02 09:31:00" || !df.IsNull(1, "trade_time") {
+		t.Errorf("期望保留时分秒且零值为空值，但得到 %v", df.Records())
+	}
+
+	var back []item
+	if err := df.ToStruct(&back); err != nil {
+		t.Fatalf("ToStruct 失败: %v", err)
+	}
+	if !back[0].TradeTime.Equal(tradeTime.Time) || !back[1].TradeTime.IsZero() {
+		t.Errorf("ToStruct 结果不正确: %v", back)
+	}
+}
@@ -0,0 +1,62 @@
+package tushare
+
+// DescribeStatColumn Describe 结果中统计项名称所在的列
+// 与数值列同名时在前面加下划线（如 _stat）直到不重名，实际列名为结果的第一列 Columns[0]
+const DescribeStatColumn = "stat"
+
+// describeStats Describe 输出的统计项，顺序即结果的行顺序
+var describeStats = []string{"count", "null", "mean", "std", "min", "25%", "50%", "75%", "max"}
+
+// Describe 计算数值列的汇总统计（类似 pandas 的 describe）
+//
+// 结果的第一列为统计项名称（count、null、mean、std、min、25%、50%、75%、max），
+// 其后每个数值列对应一列（统计项列名见 DescribeStatColumn）。count 为非空值个数，null 为空值个数；
+// 其余统计项跳过空值计算，没有有效值时为空值（std 至少需要 2 个值）。非数值列会被忽略。
+func (df *DataFrame) Describe() *DataFrame {
+	statColumn := DescribeStatColumn
+	for df.hasNumericColumn(statColumn) {
+		statColumn = "_" + statColumn
+	}
+	series := []*Series{NewStringSeries(statColumn, describeStats, nil)}
+
+	for _, s := range df.series {
+		if !s.typ.isNumeric() {
+			continue
+		}
+
+		values := nonNullFloats(s, rowRange(0, s.Len()))
+		stats := make([]float64, len(describeStats))
+		nulls := make([]bool, len(describeStats))
+		stats[0] = float64(len(values))
+		stats[1] = float64(s.Len() - len(values))
+
+		if len(values) == 0 {
+			for k := 2; k < len(stats); k++ {
+				nulls[k] = true
+			}
+		} else {
+			stats[2] = mean(values)
+			if len(values) > 1 {
+				stats[3] = std(values)
+			} else {
+				nulls[3] = true
+			}
+			// quantile 会对 values 排序，排序后首尾即为最小值和最大值
+			stats[5] = quantile(values, 0.25)
+			stats[6] = quantile(values, 0.5)
+			stats[7] = quantile(values, 0.75)
+			stats[4] = values[0]
+			stats[8] = values[len(values)-1]
+		}
+
+		series = append(series, NewFloat64Series(s.name, stats, nulls))
+	}
+
+	return newDataFrame(series)
+}
+
+// hasNumericColumn 判断是否存在名为 name 的数值列
+func (df *DataFrame) hasNumericColumn(name string) bool {
+	s := df.Column(name)
+	return s != nil && s.typ.isNumeric()
+}
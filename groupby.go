@@ -0,0 +1,280 @@
+package tushare
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// AggFunc 聚合函数，对一组行（rows 为列中的行号）计算聚合值
+// 返回 float64、int64、string、time.Time 或 nil（空值），结果列的类型根据返回值推断
+type AggFunc func(s *Series, rows []int) interface{}
+
+// GroupBy 分组结果，由 DataFrame.GroupBy 创建
+type GroupBy struct {
+	df     *DataFrame
+	keys   []*Series
+	groups [][]int // 每组的行号，按分组键升序排列
+}
+
+// GroupBy 按指定列分组，分组按键值升序排列（空值排在最后）
+func (df *DataFrame) GroupBy(cols ...string) (*GroupBy, error) {
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("group by requires at least one column")
+	}
+	keys, err := df.columns(cols)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int)
+	groups := make([][]int, 0)
+	var buf []byte
+	for i := 0; i < df.nrows; i++ {
+		buf = appendRowKey(buf[:0], keys, i, false)
+		pos, ok := positions[string(buf)]
+		if !ok {
+			pos = len(groups)
+			positions[string(buf)] = pos
+			groups = append(groups, nil)
+		}
+		groups[pos] = append(groups[pos], i)
+	}
+
+	sort.SliceStable(groups, func(a, b int) bool {
+		i, j := groups[a][0], groups[b][0]
+		for _, s := range keys {
+			if cmp := s.compare(i, j); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+
+	return &GroupBy{df: df, keys: keys, groups: groups}, nil
+}
+
+// appendRowKey 将第 i 行多个键列的组合编码追加到 buf
+func appendRowKey(buf []byte, keys []*Series, i int, textual bool) []byte {
+	for _, s := range keys {
+		buf = s.appendKey(buf, i, textual)
+	}
+	return buf
+}
+
+// NumGroups 分组数量
+func (g *GroupBy) NumGroups() int {
+	return len(g.groups)
+}
+
+// Groups 返回每组的行号（按分组键升序）
+func (g *GroupBy) Groups() [][]int {
+	return g.groups
+}
+
+// Agg 对各列执行聚合，返回以分组键为前几列、聚合结果为后续列的新 DataFrame
+// aggs 的键为待聚合的列名，结果列沿用原列名，并按原 DataFrame 的列顺序排列
+func (g *GroupBy) Agg(aggs map[string]AggFunc) (*DataFrame, error) {
+	isKey := make(map[string]bool, len(g.keys))
+	firstRows := make([]int, len(g.groups))
+	for k, rows := range g.groups {
+		firstRows[k] = rows[0]
+	}
+
+	series := make([]*Series, 0, len(g.keys)+len(aggs))
+	for _, s := range g.keys {
+		isKey[s.name] = true
+		series = append(series, s.take(firstRows))
+	}
+
+	for col := range aggs {
+		if g.df.Column(col) == nil {
+			return nil, columnNotFound(col)
+		}
+		if isKey[col] {
+			return nil, fmt.Errorf("cannot aggregate group key column: %s", col)
+		}
+	}
+
+	for _, s := range g.df.series {
+		fn, ok := aggs[s.name]
+		if !ok {
+			continue
+		}
+		values := make([]interface{}, len(g.groups))
+		for k, rows := range g.groups {
+			values[k] = fn(s, rows)
+		}
+		series = append(series, seriesFromValues(s.name, values))
+	}
+
+	return NewDataFrameFromSeries(series...)
+}
+
+// seriesFromValues 由任意值创建列，类型根据取值推断
+func seriesFromValues(name string, values []interface{}) *Series {
+	typ := inferColumnType(name, len(values), func(i int) interface{} { return values[i] })
+	s := newSeries(name, typ, len(values))
+	for _, v := range values {
+		s.appendValue(v)
+	}
+	return s
+}
+
+// ==================== 内置聚合函数 ====================
+
+// nonNullFloats 返回组内非空且可转换为数值的值
+func nonNullFloats(s *Series, rows []int) []float64 {
+	values := make([]float64, 0, len(rows))
+	for _, i := range rows {
+		if f, ok := s.Float64(i); ok {
+			values = append(values, f)
+		}
+	}
+	return values
+}
+
+// AggSum 求和（跳过空值）；整数列返回 int64，全部为空时返回 0
+func AggSum(s *Series, rows []int) interface{} {
+	if s.typ == ColumnInt64 {
+		var sum int64
+		for _, i := range rows {
+			if !s.nulls[i] {
+				sum += s.ints[i]
+			}
+		}
+		return sum
+	}
+	sum := 0.0
+	for _, f := range nonNullFloats(s, rows) {
+		sum += f
+	}
+	return sum
+}
+
+// AggMean 平均值（跳过空值），全部为空时返回空值
+func AggMean(s *Series, rows []int) interface{} {
+	values := nonNullFloats(s, rows)
+	if len(values) == 0 {
+		return nil
+	}
+	return mean(values)
+}
+
+// AggMedian 中位数（跳过空值），全部为空时返回空值
+func AggMedian(s *Series, rows []int) interface{} {
+	values := nonNullFloats(s, rows)
+	if len(values) == 0 {
+		return nil
+	}
+	return quantile(values, 0.5)
+}
+
+// AggStd 样本标准差（跳过空值），有效值少于 2 个时返回空值
+func AggStd(s *Series, rows []int) interface{} {
+	values := nonNullFloats(s, rows)
+	if len(values) < 2 {
+		return nil
+	}
+	return std(values)
+}
+
+// AggMin 最小值（跳过空值），支持数值、字符串和日期列
+func AggMin(s *Series, rows []int) interface{} {
+	return extreme(s, rows, -1)
+}
+
+// AggMax 最大值（跳过空值），支持数值、字符串和日期列
+func AggMax(s *Series, rows []int) interface{} {
+	return extreme(s, rows, 1)
+}
+
+// AggCount 非空值个数
+func AggCount(s *Series, rows []int) interface{} {
+	var n int64
+	for _, i := range rows {
+		if !s.nulls[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// AggFirst 第一个非空值
+func AggFirst(s *Series, rows []int) interface{} {
+	for _, i := range rows {
+		if !s.nulls[i] {
+			return s.Value(i)
+		}
+	}
+	return nil
+}
+
+// AggLast 最后一个非空值
+func AggLast(s *Series, rows []int) interface{} {
+	for k := len(rows) - 1; k >= 0; k-- {
+		if i := rows[k]; !s.nulls[i] {
+			return s.Value(i)
+		}
+	}
+	return nil
+}
+
+// AggFloat64 将作用于数值切片的自定义函数包装为聚合函数
+// fn 接收组内非空的数值，组内没有有效值时结果为空值
+func AggFloat64(fn func(values []float64) float64) AggFunc {
+	return func(s *Series, rows []int) interface{} {
+		values := nonNullFloats(s, rows)
+		if len(values) == 0 {
+			return nil
+		}
+		return fn(values)
+	}
+}
+
+// extreme 返回组内最小（sign<0）或最大（sign>0）的非空值
+func extreme(s *Series, rows []int, sign int) interface{} {
+	best := -1
+	for _, i := range rows {
+		if s.nulls[i] {
+			continue
+		}
+		if best < 0 || s.compare(i, best)*sign > 0 {
+			best = i
+		}
+	}
+	if best < 0 {
+		return nil
+	}
+	return s.Value(best)
+}
+
+// ==================== 统计工具 ====================
+
+// mean 平均值
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// std 样本标准差（自由度 n-1）
+func std(values []float64) float64 {
+	m := mean(values)
+	ss := 0.0
+	for _, v := range values {
+		ss += (v - m) * (v - m)
+	}
+	return math.Sqrt(ss / float64(len(values)-1))
+}
+
+// quantile 线性插值的分位数，会对 values 排序
+func quantile(values []float64, q float64) float64 {
+	sort.Float64s(values)
+	pos := q * float64(len(values)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return values[lo] + (values[hi]-values[lo])*(pos-float64(lo))
+}
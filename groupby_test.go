@@ -0,0 +1,113 @@
+package tushare
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDataFrame_GroupByAgg(t *testing.T) {
+	df := newBasicFrame()
+
+	g, err := df.GroupBy("industry")
+	if err != nil {
+		t.Fatalf("GroupBy 失败: %v", err)
+	}
+	if g.NumGroups() != 3 {
+		t.Errorf("期望 3 组，但得到 %d", g.NumGroups())
+	}
+
+	result, err := g.Agg(map[string]AggFunc{
+		"pe":       AggMean,
+		"total_mv": AggSum,
+		"ts_code":  AggCount,
+	})
+	if err != nil {
+		t.Fatalf("Agg 失败: %v", err)
+	}
+
+	// 分组键在前，聚合列按原列顺序
+	assertStrings(t, result.Columns, []string{"industry", "ts_code", "pe", "total_mv"})
+	// 分组按键升序
+	assertStrings(t, columnStrings(result, "industry"), []string{"全国地产", "白酒", "银行"})
+
+	if result.Column("ts_code").Type() != ColumnInt64 {
+		t.Errorf("期望计数列为 int64，但得到 %s", result.Column("ts_code").Type())
+	}
+	assertStrings(t, columnStrings(result, "ts_code"), []string{"1", "2", "2"})
+
+	// 全部为空的组，均值为空值
+	if !result.Column("pe").IsNull(0) {
+		t.Error("期望全国地产的 pe 均值为空值")
+	}
+	if got := result.GetFloat64(2, "pe"); math.Abs(got-5.15) > 1e-9 {
+		t.Errorf("期望银行 pe 均值为 5.15，但得到 %f", got)
+	}
+	if got := result.GetFloat64(1, "total_mv"); got != 26000 {
+		t.Errorf("期望白酒总市值为 26000，但得到 %f", got)
+	}
+}
+
+func TestDataFrame_GroupByMultiKeys(t *testing.T) {
+	df := newBasicFrame()
+
+	g, err := df.GroupBy("trade_date", "industry")
+	if err != nil {
+		t.Fatalf("GroupBy 失败: %v", err)
+	}
+
+	result, err := g.Agg(map[string]AggFunc{
+		"pe": AggMax,
+		"total_mv": AggFloat64(func(values []float64) float64 {
+			return values[len(values)-1] - values[0]
+		}),
+		"ts_code": AggFirst,
+	})
+	if err != nil {
+		t.Fatalf("Agg 失败: %v", err)
+	}
+
+	if result.Len() != 3 {
+		t.Fatalf("期望 3 组，但得到 %d", result.Len())
+	}
+	if result.Column("trade_date").Type() != ColumnDate {
+		t.Errorf("期望分组键保留日期类型，但得到 %s", result.Column("trade_date").Type())
+	}
+	assertStrings(t, columnStrings(result, "ts_code"), []string{"000002.SZ", "000001.SZ", "600519.SH"})
+	assertStrings(t, columnStrings(result, "pe"), []string{"", "5.8", "28.1"})
+	assertStrings(t, columnStrings(result, "total_mv"), []string{"0", "6000", "-16000"})
+}
+
+func TestAggFuncs(t *testing.T) {
+	s := NewFloat64Series("v", []float64{1, 0, 3, 4, 2}, []bool{false, true, false, false, false})
+	rows := []int{0, 1, 2, 3, 4}
+
+	if got := AggMedian(s, rows); got != 2.5 {
+		t.Errorf("期望中位数 2.5，但得到 %v", got)
+	}
+	if got := AggMin(s, rows); got != 1.0 {
+		t.Errorf("期望最小值 1，但得到 %v", got)
+	}
+	if got := AggLast(s, rows); got != 2.0 {
+		t.Errorf("期望最后一个值 2，但得到 %v", got)
+	}
+	if got := AggCount(s, rows); got != int64(4) {
+		t.Errorf("期望计数 4，但得到 %v", got)
+	}
+	if got := AggStd(s, rows).(float64); math.Abs(got-1.2909944487) > 1e-9 {
+		t.Errorf("期望标准差 1.2910，但得到 %v", got)
+	}
+	if got := AggStd(s, []int{0}); got != nil {
+		t.Errorf("单个值的标准差应为空值，但得到 %v", got)
+	}
+	if got := AggSum(s, []int{1}); got != 0.0 {
+		t.Errorf("全部为空的和应为 0，但得到 %v", got)
+	}
+
+	if _, err := newBasicFrame().GroupBy(); err == nil {
+		t.Error("未指定分组列时应返回错误")
+	}
+	g, _ := newBasicFrame().GroupBy("industry")
+	if _, err := g.Agg(map[string]AggFunc{"industry": AggCount}); err == nil {
+		t.Error("聚合分组键时应返回错误")
+	}
+}
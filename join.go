@@ -0,0 +1,210 @@
+package tushare
+
+import (
+	"fmt"
+)
+
+// JoinType 连接方式
+type JoinType string
+
+const (
+	// JoinInner 内连接，只保留两侧都匹配的行
+	JoinInner JoinType = "inner"
+	// JoinLeft 左连接，保留左侧全部行
+	JoinLeft JoinType = "left"
+	// JoinRight 右连接，保留右侧全部行
+	JoinRight JoinType = "right"
+	// JoinOuter 全外连接，保留两侧全部行
+	JoinOuter JoinType = "outer"
+)
+
+// JoinOption 连接选项
+type JoinOption func(*joinOptions)
+
+type joinOptions struct {
+	leftSuffix  string
+	rightSuffix string
+}
+
+// WithSuffixes 设置两侧非连接列重名时追加的后缀，默认 "_x" 和 "_y"
+func WithSuffixes(left, right string) JoinOption {
+	return func(o *joinOptions) {
+		o.leftSuffix = left
+		o.rightSuffix = right
+	}
+}
+
+// Join 按键列哈希连接两个 DataFrame
+//
+// 结果列依次为左侧全部列（键列保持原位置）和右侧非键列；两侧重名的非键列追加后缀。
+// 行顺序：inner/left/outer 按左侧行顺序（outer 的右侧未匹配行追加在最后），right 按右侧行顺序。
+// 键列包含空值的行不会与任何行匹配。
+func (df *DataFrame) Join(other *DataFrame, on []string, how JoinType, opts ...JoinOption) (*DataFrame, error) {
+	options := &joinOptions{
+		leftSuffix:  "_x",
+		rightSuffix: "_y",
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if len(on) == 0 {
+		return nil, fmt.Errorf("join requires at least one key column")
+	}
+	leftKeys, err := df.columns(on)
+	if err != nil {
+		return nil, err
+	}
+	rightKeys, err := other.columns(on)
+	if err != nil {
+		return nil, err
+	}
+
+	// 两侧键列类型不兼容（如日期与字符串）时按字符串形式匹配
+	textual := false
+	for k := range leftKeys {
+		if !keyCompatible(leftKeys[k].typ, rightKeys[k].typ) {
+			textual = true
+		}
+	}
+
+	var leftIdx, rightIdx []int
+	switch how {
+	case JoinInner, JoinLeft, JoinOuter:
+		leftIdx, rightIdx = hashJoin(leftKeys, df.nrows, rightKeys, other.nrows, textual, how != JoinInner, how == JoinOuter)
+	case JoinRight:
+		rightIdx, leftIdx = hashJoin(rightKeys, other.nrows, leftKeys, df.nrows, textual, true, false)
+	default:
+		return nil, fmt.Errorf("unsupported join type: %q", how)
+	}
+
+	isKey := make(map[string]bool, len(on))
+	for _, col := range on {
+		isKey[col] = true
+	}
+
+	rightNames := make(map[string]bool, len(other.series))
+	for _, s := range other.series {
+		if !isKey[s.name] {
+			rightNames[s.name] = true
+		}
+	}
+	leftNames := make(map[string]bool, len(df.series))
+	for _, s := range df.series {
+		leftNames[s.name] = true
+	}
+
+	series := make([]*Series, 0, len(df.series)+len(other.series))
+	for _, s := range df.series {
+		if isKey[s.name] {
+			series = append(series, coalesceKey(s, other.Column(s.name), leftIdx, rightIdx))
+			continue
+		}
+		taken := s.take(leftIdx)
+		if rightNames[s.name] {
+			taken.name += options.leftSuffix
+		}
+		series = append(series, taken)
+	}
+	for _, s := range other.series {
+		if isKey[s.name] {
+			continue
+		}
+		taken := s.take(rightIdx)
+		if leftNames[s.name] {
+			taken.name += options.rightSuffix
+		}
+		series = append(series, taken)
+	}
+
+	return NewDataFrameFromSeries(series...)
+}
+
+// hashJoin 以 build 侧建立哈希表，按 probe 侧行顺序输出匹配的行号对
+// keepProbe 保留 probe 侧未匹配的行，keepBuild 在最后追加 build 侧未匹配的行
+func hashJoin(probeKeys []*Series, probeRows int, buildKeys []*Series, buildRows int, textual, keepProbe, keepBuild bool) ([]int, []int) {
+	// 哈希表只记录每个键的首行，同键的其余行通过 next 串联，避免为每个键分配切片
+	head := make(map[string]int, buildRows)
+	next := make([]int, buildRows)
+	var buf []byte
+	for j := buildRows - 1; j >= 0; j-- {
+		next[j] = -1
+		if hasNullKey(buildKeys, j) {
+			continue
+		}
+		buf = appendRowKey(buf[:0], buildKeys, j, textual)
+		if first, ok := head[string(buf)]; ok {
+			next[j] = first
+		}
+		head[string(buf)] = j
+	}
+
+	probeIdx := make([]int, 0, probeRows)
+	buildIdx := make([]int, 0, probeRows)
+	var matched []bool
+	if keepBuild {
+		matched = make([]bool, buildRows)
+	}
+
+	for i := 0; i < probeRows; i++ {
+		j := -1
+		if !hasNullKey(probeKeys, i) {
+			buf = appendRowKey(buf[:0], probeKeys, i, textual)
+			if first, ok := head[string(buf)]; ok {
+				j = first
+			}
+		}
+		if j < 0 {
+			if keepProbe {
+				probeIdx = append(probeIdx, i)
+				buildIdx = append(buildIdx, -1)
+			}
+			continue
+		}
+		for ; j >= 0; j = next[j] {
+			probeIdx = append(probeIdx, i)
+			buildIdx = append(buildIdx, j)
+			if keepBuild {
+				matched[j] = true
+			}
+		}
+	}
+
+	if keepBuild {
+		for j, ok := range matched {
+			if !ok {
+				probeIdx = append(probeIdx, -1)
+				buildIdx = append(buildIdx, j)
+			}
+		}
+	}
+	return probeIdx, buildIdx
+}
+
+// keyCompatible 判断两种列类型的二进制键编码是否可以直接比较
+func keyCompatible(a, b ColumnType) bool {
+	return a == b || (a.isNumeric() && b.isNumeric())
+}
+
+// hasNullKey 判断第 i 行的键列是否包含空值
+func hasNullKey(keys []*Series, i int) bool {
+	for _, s := range keys {
+		if s.nulls[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// coalesceKey 合并两侧的键列：左侧有匹配行时取左侧值，否则取右侧值
+func coalesceKey(left, right *Series, leftIdx, rightIdx []int) *Series {
+	result := newSeries(left.name, left.typ, len(leftIdx))
+	for k, i := range leftIdx {
+		if i >= 0 {
+			result.appendFrom(left, i)
+		} else {
+			result.appendFrom(right, rightIdx[k])
+		}
+	}
+	return result
+}
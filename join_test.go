@@ -0,0 +1,120 @@
+package tushare
+
+import (
+	"fmt"
+	"testing"
+)
+
+func newQuoteFrames() (*DataFrame, *DataFrame) {
+	daily := NewDataFrame(&Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "trade_date", "close"},
+			Items: [][]interface{}{
+				{"000001.SZ", "20240102", 9.39},
+				{"000001.SZ", "20240103", 9.19},
+				{"000002.SZ", "20240102", 10.5},
+			},
+		},
+	})
+	basic := NewDataFrame(&Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "trade_date", "close", "pe"},
+			Items: [][]interface{}{
+				{"000001.SZ", "20240103", 9.19, 4.4},
+				{"000001.SZ", "20240102", 9.39, 4.5},
+				{"600000.SH", "20240102", 6.6, 5.1},
+			},
+		},
+	})
+	return daily, basic
+}
+
+func TestDataFrame_JoinTypes(t *testing.T) {
+	daily, basic := newQuoteFrames()
+	on := []string{"ts_code", "trade_date"}
+
+	cases := []struct {
+		how   JoinType
+		codes []string
+		pe    []string
+	}{
+		{JoinInner, []string{"000001.SZ", "000001.SZ"}, []string{"4.5", "4.4"}},
+		{JoinLeft, []string{"000001.SZ", "000001.SZ", "000002.SZ"}, []string{"4.5", "4.4", ""}},
+		{JoinRight, []string{"000001.SZ", "000001.SZ", "600000.SH"}, []string{"4.4", "4.5", "5.1"}},
+		{JoinOuter, []string{"000001.SZ", "000001.SZ", "000002.SZ", "600000.SH"}, []string{"4.5", "4.4", "", "5.1"}},
+	}
+	for _, c := range cases {
+		result, err := daily.Join(basic, on, c.how)
+		if err != nil {
+			t.Fatalf("%s join 失败: %v", c.how, err)
+		}
+		assertStrings(t, columnStrings(result, "ts_code"), c.codes)
+		assertStrings(t, columnStrings(result, "pe"), c.pe)
+	}
+}
+
+func TestDataFrame_JoinSuffixes(t *testing.T) {
+	daily, basic := newQuoteFrames()
+
+	result, err := daily.Join(basic, []string{"ts_code", "trade_date"}, JoinLeft)
+	if err != nil {
+		t.Fatalf("join 失败: %v", err)
+	}
+	assertStrings(t, result.Columns, []string{"ts_code", "trade_date", "close_x", "close_y", "pe"})
+	if result.Column("trade_date").Type() != ColumnDate {
+		t.Errorf("期望键列保留日期类型，但得到 %s", result.Column("trade_date").Type())
+	}
+	if !result.Column("close_y").IsNull(2) {
+		t.Error("左连接未匹配的行右侧列应为空值")
+	}
+
+	result, err = daily.Join(basic, []string{"ts_code", "trade_date"}, JoinInner, WithSuffixes("", "_basic"))
+	if err != nil {
+		t.Fatalf("join 失败: %v", err)
+	}
+	assertStrings(t, result.Columns, []string{"ts_code", "trade_date", "close", "close_basic", "pe"})
+
+	if _, err := daily.Join(basic, []string{"pe"}, JoinInner); err == nil {
+		t.Error("键列不存在时应返回错误")
+	}
+	if _, err := daily.Join(basic, []string{"ts_code"}, "cross"); err == nil {
+		t.Error("不支持的连接方式应返回错误")
+	}
+}
+
+func TestDataFrame_JoinOneToMany(t *testing.T) {
+	daily, _ := newQuoteFrames()
+	industry := NewDataFrame(&Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "industry"},
+			Items: [][]interface{}{
+				{"000001.SZ", "银行"},
+				{"000002.SZ", "全国地产"},
+				{nil, "未知"},
+			},
+		},
+	})
+
+	result, err := daily.Join(industry, []string{"ts_code"}, JoinLeft)
+	if err != nil {
+		t.Fatalf("join 失败: %v", err)
+	}
+	assertStrings(t, columnStrings(result, "industry"), []string{"银行", "银行", "全国地产"})
+}
+
+func BenchmarkDataFrame_Join(b *testing.B) {
+	codes := make([]string, 400)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("%06d.SZ", i+1)
+	}
+	left := NewDataFrame(newDailyResponse(codes, 250))
+	right, _ := left.Select("ts_code", "trade_date", "close")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := left.Join(right, []string{"ts_code", "trade_date"}, JoinInner); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package tushare
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// JSONLinesOptions JSON Lines 写入选项，nil 表示使用默认值
+type JSONLinesOptions struct {
+	Gzip      bool // 使用 gzip 压缩输出
+	GzipLevel int  // gzip 压缩级别（gzip.BestSpeed ~ gzip.BestCompression），0 表示默认级别
+}
+
+// JSONLinesWriter 以 JSON Lines（NDJSON）格式逐行写入数据，每行一个 JSON 对象
+//
+// 对象的键顺序与字段顺序一致（ResponseData.Fields 或 DataFrame.Columns），
+// 写入完成后必须调用 Close 刷新缓冲区（不会关闭底层的 io.Writer）。
+type JSONLinesWriter struct {
+	buf  *bufio.Writer
+	gz   *gzip.Writer
+	line []byte
+	rows int64
+}
+
+// NewJSONLinesWriter 创建 JSON Lines 写入器
+func NewJSONLinesWriter(w io.Writer, opts *JSONLinesOptions) (*JSONLinesWriter, error) {
+	if opts == nil {
+		opts = &JSONLinesOptions{}
+	}
+
+	jw := &JSONLinesWriter{}
+	if opts.Gzip {
+		level := opts.GzipLevel
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		gz, err := gzip.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, err
+		}
+		jw.gz = gz
+		w = gz
+	}
+	jw.buf = bufio.NewWriter(w)
+	return jw, nil
+}
+
+// Rows 已写入的行数
+func (w *JSONLinesWriter) Rows() int64 {
+	return w.rows
+}
+
+// WriteResponse 写入响应中的全部数据行
+func (w *JSONLinesWriter) WriteResponse(resp *Response) error {
+	if resp == nil || resp.Data == nil {
+		return nil
+	}
+	return w.WriteData(resp.Data)
+}
+
+// WriteData 写入一页数据的全部行
+func (w *JSONLinesWriter) WriteData(data *ResponseData) error {
+	keys := jsonKeys(data.Fields)
+	for _, item := range data.Items {
+		err := w.writeRow(keys, func(j int) interface{} {
+			if j < len(item) {
+				return item[j]
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteDataFrame 写入 DataFrame 的全部行，日期列写为 YYYYMMDD 字符串，空值写为 null
+func (w *JSONLinesWriter) WriteDataFrame(df *DataFrame) error {
+	keys := jsonKeys(df.Columns)
+	for i := 0; i < df.nrows; i++ {
+		err := w.writeRow(keys, func(j int) interface{} {
+			v, _ := df.Get(i, df.Columns[j])
+			return v
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteItem 将任意值（如 *market.DailyItem）编码为一行
+func (w *JSONLinesWriter) WriteItem(item interface{}) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("marshal item failed: %w", err)
+	}
+	w.line = append(append(w.line[:0], data...), '\n')
+	return w.flushLine()
+}
+
+// Close 刷新缓冲区并结束 gzip 流，不会关闭底层的 io.Writer
+func (w *JSONLinesWriter) Close() error {
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if w.gz != nil {
+		return w.gz.Close()
+	}
+	return nil
+}
+
+// writeRow 按 keys 的顺序编码一行
+func (w *JSONLinesWriter) writeRow(keys [][]byte, value func(j int) interface{}) error {
+	line := append(w.line[:0], '{')
+	for j, key := range keys {
+		if j > 0 {
+			line = append(line, ',')
+		}
+		line = append(line, key...)
+		line = append(line, ':')
+
+		var err error
+		line, err = appendJSONValue(line, value(j))
+		if err != nil {
+			return err
+		}
+	}
+	w.line = append(line, '}', '\n')
+	return w.flushLine()
+}
+
+// flushLine 将当前行写入缓冲区
+func (w *JSONLinesWriter) flushLine() error {
+	if _, err := w.buf.Write(w.line); err != nil {
+		return err
+	}
+	w.rows++
+	return nil
+}
+
+// jsonKeys 将字段名编码为 JSON 字符串
+func jsonKeys(fields []string) [][]byte {
+	keys := make([][]byte, len(fields))
+	for j, field := range fields {
+		keys[j], _ = json.Marshal(field)
+	}
+	return keys
+}
+
+// appendJSONValue 将值编码为 JSON 追加到 buf，NaN 和 Inf 编码为 null
+func appendJSONValue(buf []byte, v interface{}) ([]byte, error) {
+	switch x := v.(type) {
+	case nil:
+		return append(buf, "null"...), nil
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return append(buf, "null"...), nil
+		}
+		return strconv.AppendFloat(buf, x, 'f', -1, 64), nil
+	case int64:
+		return strconv.AppendInt(buf, x, 10), nil
+	case string:
+		return appendJSONString(buf, x), nil
+	case time.Time:
+		return appendJSONString(buf, x.Format(DateLayout)), nil
+	default:
+		data, err := json.Marshal(x)
+		if err != nil {
+			return buf, fmt.Errorf("marshal value failed: %w", err)
+		}
+		return append(buf, data...), nil
+	}
+}
+
+// appendJSONString 将字符串编码为 JSON 字符串追加到 buf
+func appendJSONString(buf []byte, s string) []byte {
+	data, _ := json.Marshal(s)
+	return append(buf, data...)
+}
+
+// WriteItemsJSONLines 将结构体切片（如 []*market.DailyItem）写入 JSON Lines，键名使用字段的 json 标签
+func WriteItemsJSONLines[T any](w io.Writer, items []T, opts *JSONLinesOptions) error {
+	jw, err := NewJSONLinesWriter(w, opts)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := jw.WriteItem(item); err != nil {
+			return err
+		}
+	}
+	return jw.Close()
+}
+
+// QueryToJSONLines 执行查询（自动处理分页）并将每页数据直接写入 w，不在内存中合并全部数据
+// 返回写入的行数；查询中途失败时已写入的行会被保留
+func (c *Client) QueryToJSONLines(w io.Writer, apiName string, params map[string]interface{}, fields string, jsonOpts *JSONLinesOptions, opts ...QueryOption) (int64, error) {
+	jw, err := NewJSONLinesWriter(w, jsonOpts)
+	if err != nil {
+		return 0, err
+	}
+
+	err = c.QueryPages(apiName, params, fields, jw.WriteData, opts...)
+	if closeErr := jw.Close(); err == nil {
+		err = closeErr
+	}
+	return jw.Rows(), err
+}
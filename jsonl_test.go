@@ -0,0 +1,198 @@
+package tushare
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newPagedServer 按 offset 返回分页数据的模拟服务器，failAt 页返回 API 错误（-1 表示不失败）
+func newPagedServer(t *testing.T, pages [][][]interface{}, failAt int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqParams RequestParams
+		if err := json.NewDecoder(r.Body).Decode(&reqParams); err != nil {
+			t.Errorf("解析请求体失败: %v", err)
+			return
+		}
+
+		offset, _ := reqParams.Params["offset"].(float64)
+		limit, _ := reqParams.Params["limit"].(float64)
+		page := int(offset / limit)
+
+		response := Response{Code: 0}
+		if page == failAt {
+			response = Response{Code: 40101, Msg: "抱歉，您没有访问该接口的权限"}
+		} else {
+			response.Data = &ResponseData{
+				Fields:  []string{"ts_code", "name", "close"},
+				Items:   pages[page],
+				HasMore: page < len(pages)-1,
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestJSONLinesWriter_WriteResponse(t *testing.T) {
+	resp := &Response{
+		Data: &ResponseData{
+			// 字段顺序不按字母排列，输出的键顺序应与之一致
+			Fields: []string{"ts_code", "name", "close", "pe"},
+			Items: [][]interface{}{
+				{"000001.SZ", "平安银行", 9.39, nil},
+				{"000002.SZ", "万科A", 1234567.5, 8.2},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewJSONLinesWriter(&buf, nil)
+	if err != nil {
+		t.Fatalf("创建写入器失败: %v", err)
+	}
+	if err := w.WriteResponse(resp); err != nil {
+		t.Fatalf("WriteResponse 失败: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close 失败: %v", err)
+	}
+
+	want := `{"ts_code":"000001.SZ","name":"平安银行","close":9.39,"pe":null}` + "\n" +
+		`{"ts_code":"000002.SZ","name":"万科A","close":1234567.5,"pe":8.2}` + "\n"
+	if buf.String() != want {
+		t.Errorf("期望:\n%s但得到:\n%s", want, buf.String())
+	}
+	if w.Rows() != 2 {
+		t.Errorf("期望写入 2 行，但得到 %d", w.Rows())
+	}
+}
+
+func TestJSONLinesWriter_WriteDataFrame(t *testing.T) {
+	df := newTestFrame(t,
+		NewDateSeries("trade_date", parseDates("20240102"), nil),
+		NewFloat64Series("close", []float64{math.NaN()}, nil),
+		NewInt64Series("vol", []int64{100}, nil),
+		NewStringSeries("note", []string{""}, []bool{true}),
+	)
+
+	var buf bytes.Buffer
+	w, _ := NewJSONLinesWriter(&buf, nil)
+	if err := w.WriteDataFrame(df); err != nil {
+		t.Fatalf("WriteDataFrame 失败: %v", err)
+	}
+	w.Close()
+
+	want := `{"trade_date":"20240102","close":null,"vol":100,"note":null}` + "\n"
+	if buf.String() != want {
+		t.Errorf("期望 %s但得到 %s", want, buf.String())
+	}
+}
+
+func TestWriteItemsJSONLines_Gzip(t *testing.T) {
+	type item struct {
+		TSCode string  `json:"ts_code"`
+		Close  float64 `json:"close"`
+	}
+	items := []*item{{TSCode: "000001.SZ", Close: 9.39}, {TSCode: "000002.SZ", Close: 10.5}}
+
+	var buf bytes.Buffer
+	if err := WriteItemsJSONLines(&buf, items, &JSONLinesOptions{Gzip: true}); err != nil {
+		t.Fatalf("WriteItemsJSONLines 失败: %v", err)
+	}
+
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("读取 gzip 失败: %v", err)
+	}
+	data, _ := io.ReadAll(zr)
+
+	want := `{"ts_code":"000001.SZ","close":9.39}` + "\n" + `{"ts_code":"000002.SZ","close":10.5}` + "\n"
+	if string(data) != want {
+		t.Errorf("期望:\n%s但得到:\n%s", want, data)
+	}
+}
+
+func TestClient_QueryToJSONLines(t *testing.T) {
+	pages := [][][]interface{}{
+		{{"000001.SZ", "平安银行", 9.39}, {"000002.SZ", "万科A", 10.5}},
+		{{"000004.SZ", "国华网安", 20.1}},
+	}
+	server := newPagedServer(t, pages, -1)
+	defer server.Close()
+
+	client := NewClient("test_token", WithHTTPURL(server.URL), WithLimit(2))
+
+	var buf bytes.Buffer
+	n, err := client.QueryToJSONLines(&buf, "stock_basic", nil, "", nil)
+	if err != nil {
+		t.Fatalf("QueryToJSONLines 失败: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("期望写入 3 行，但得到 %d", n)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || lines[2] != `{"ts_code":"000004.SZ","name":"国华网安","close":20.1}` {
+		t.Errorf("输出不符合预期: %q", lines)
+	}
+}
+
+func TestClient_QueryToJSONLines_PartialFailure(t *testing.T) {
+	pages := [][][]interface{}{
+		{{"000001.SZ", "平安银行", 9.39}, {"000002.SZ", "万科A", 10.5}},
+		nil,
+	}
+	server := newPagedServer(t, pages, 1)
+	defer server.Close()
+
+	client := NewClient("test_token", WithHTTPURL(server.URL), WithLimit(2))
+
+	var buf bytes.Buffer
+	n, err := client.QueryToJSONLines(&buf, "stock_basic", nil, "", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("期望 APIError，但得到 %v", err)
+	}
+	// 失败前已写入的页被保留
+	if n != 2 || strings.Count(buf.String(), "\n") != 2 {
+		t.Errorf("期望保留 2 行，但得到 %d 行: %q", n, buf.String())
+	}
+}
+
+func TestClient_QueryPages(t *testing.T) {
+	pages := [][][]interface{}{
+		{{"000001.SZ", "平安银行", 9.39}, {"000002.SZ", "万科A", 10.5}},
+		{{"000004.SZ", "国华网安", 20.1}, {"000005.SZ", "ST星源", 1.5}},
+		{{"000006.SZ", "深振业A", 5.1}},
+	}
+	server := newPagedServer(t, pages, -1)
+	defer server.Close()
+
+	client := NewClient("test_token", WithHTTPURL(server.URL), WithLimit(2))
+
+	// 回调返回错误时停止分页
+	stop := errors.New("stop")
+	calls := 0
+	err := client.QueryPages("stock_basic", nil, "", func(page *ResponseData) error {
+		calls++
+		if calls == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("期望返回回调的错误，但得到 %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("期望回调 2 次，但得到 %d", calls)
+	}
+}
@@ -0,0 +1,230 @@
+package tushare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// Request 一次发往 Tushare 的请求
+// 同一次调用的所有重试共享同一个 *Request，中间件对它的修改会作用于后续每次尝试
+type Request struct {
+	Params   RequestParams   // 请求参数
+	Ctx      context.Context // 请求上下文
+	Header   http.Header     // 附加的 HTTP 请求头
+	Priority Priority        // 请求优先级
+
+	gates []*requestGate // 外层 RateLimitedQuerier 的限频关卡
+}
+
+// ErrNilResponse 处理函数既没有返回响应也没有返回错误
+var ErrNilResponse = errors.New("handler returned nil response")
+
+// Handler 请求处理函数
+// 返回的 error 为 nil 时 *Response 必须非空，短路返回的中间件需要构造完整的响应；
+// 否则客户端的处理链会将其视为 ErrNilResponse 错误。
+type Handler func(req *Request) (*Response, error)
+
+// Middleware 请求中间件，可以修改请求、短路返回或检查响应
+type Middleware func(next Handler) Handler
+
+// Chain 将多个中间件组合为一个，第一个中间件位于最外层
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next Handler) Handler {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+		return next
+	}
+}
+
+// buildHandler 构建客户端的请求处理链
+// 从外到内依次为：重试 -> 用户中间件 -> 限频调度 -> HTTP 请求。
+// 用户中间件在每次尝试时都会执行，且在获取限频配额之前，缓存命中不会消耗配额。
+func (c *Client) buildHandler() Handler {
+	var h Handler = c.doRequest
+	h = gateMiddleware(h)
+	if c.scheduler != nil {
+		h = schedulerMiddleware(c.scheduler)(h)
+	}
+	h = Chain(c.middlewares...)(h)
+
+	retry := RetryConfig{
+		MaxRetries:   c.conf.Retries,
+		InitialDelay: c.conf.Interval,
+		MaxDelay:     c.conf.MaxInterval,
+		UseBackoff:   c.conf.UseBackoff,
+	}
+	return newRetryMiddleware(retry, c.notifyRetry)(h)
+}
+
+// ==================== 内置中间件 ====================
+
+// RetryMiddleware 重试中间件，网络错误和限频错误会按 RetryConfig 重试
+// 客户端默认已在最外层启用重试，通常只在自定义处理链时使用
+func RetryMiddleware(retry RetryConfig) Middleware {
+	return newRetryMiddleware(retry, nil)
+}
+
+// newRetryMiddleware 创建带重试通知的重试中间件
+func newRetryMiddleware(retry RetryConfig, notify backoff.Notify) Middleware {
+	return func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			var resp *Response
+
+			// 定义重试操作
+			operation := func() error {
+				var err error
+				resp, err = next(req)
+
+				// 下游没有返回响应，不再重试
+				if err == nil && resp == nil {
+					return backoff.Permanent(ErrNilResponse)
+				}
+
+				// 成功直接返回
+				if err == nil && resp.IsSuccess() {
+					return nil
+				}
+
+				// 上下文已取消，不再重试
+				if err != nil && req.Ctx.Err() != nil {
+					return backoff.Permanent(err)
+				}
+
+				// 判断是否可重试
+				if !isRetryableError(resp, err) {
+					// 不可重试的错误，使用 backoff.Permanent 终止重试
+					if err != nil {
+						return backoff.Permanent(err)
+					}
+					// API 业务错误（非限频），不重试但返回结果
+					return nil
+				}
+
+				// 可重试的错误
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("api error: code=%d, msg=%s", resp.Code, resp.Msg)
+			}
+
+			// 配置 backoff 策略
+			var b backoff.BackOff
+			if retry.UseBackoff {
+				// 指数退避
+				expBackoff := backoff.NewExponentialBackOff()
+				expBackoff.InitialInterval = retry.InitialDelay
+				expBackoff.MaxInterval = retry.MaxDelay
+				expBackoff.Multiplier = 2
+				expBackoff.RandomizationFactor = 0.1
+				b = backoff.WithMaxRetries(expBackoff, uint64(retry.MaxRetries))
+			} else {
+				// 固定间隔退避
+				constBackoff := backoff.NewConstantBackOff(retry.InitialDelay)
+				b = backoff.WithMaxRetries(constBackoff, uint64(retry.MaxRetries))
+			}
+
+			// 包装上下文支持取消
+			b = backoff.WithContext(b, req.Ctx)
+
+			// 执行重试
+			if err := backoff.RetryNotify(operation, b, notify); err != nil {
+				return nil, err
+			}
+
+			return resp, nil
+		}
+	}
+}
+
+// RateLimitMiddleware 按优先级限频的中间件，perMinute 为每分钟最大请求数
+// 与 WithRateLimit 等价，每次尝试（包括重试）都会消耗一个配额
+func RateLimitMiddleware(perMinute int) Middleware {
+	s := newSchedulerPerMinute(perMinute, DefaultMaxStarvation)
+	if s == nil {
+		return func(next Handler) Handler {
+			return next
+		}
+	}
+	return schedulerMiddleware(s)
+}
+
+// schedulerMiddleware 在调用下游前获取限频配额
+func schedulerMiddleware(s *scheduler) Middleware {
+	return func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			if err := s.acquire(req.Ctx, req.Priority); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// gateMiddleware 在调用下游前依次通过请求携带的限频关卡
+func gateMiddleware(next Handler) Handler {
+	return func(req *Request) (*Response, error) {
+		for _, g := range req.gates {
+			if err := g.take(req.Ctx, req.Priority); err != nil {
+				return nil, err
+			}
+		}
+		return next(req)
+	}
+}
+
+// LoggingMiddleware 记录每次 HTTP 请求的接口名、分页参数、返回码和耗时（不记录 Token）
+func LoggingMiddleware(logger Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			elapsed := time.Since(start)
+
+			if err != nil {
+				logger.Printf("tushare request api=%s params=%v elapsed=%v error=%v", req.Params.APIName, req.Params.Params, elapsed, err)
+				return resp, err
+			}
+			if resp == nil {
+				logger.Printf("tushare request api=%s params=%v elapsed=%v error=%v", req.Params.APIName, req.Params.Params, elapsed, ErrNilResponse)
+				return resp, err
+			}
+
+			rows := 0
+			if resp.Data != nil {
+				rows = len(resp.Data.Items)
+			}
+			logger.Printf("tushare request api=%s params=%v elapsed=%v code=%d rows=%d", req.Params.APIName, req.Params.Params, elapsed, resp.Code, rows)
+			return resp, err
+		}
+	}
+}
+
+// CacheMiddleware 缓存成功响应的中间件，ttl <= 0 表示永不过期
+// 缓存粒度为单次 HTTP 请求（分页参数是缓存键的一部分），命中时直接短路返回
+func CacheMiddleware(ttl time.Duration) Middleware {
+	cache := newResponseCache(ttl)
+	return func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			key, ok := cacheKey("request", req.Params.APIName, req.Params.Params, req.Params.Fields)
+			if !ok {
+				return next(req)
+			}
+
+			if resp, hit := cache.get(key); hit {
+				return resp, nil
+			}
+
+			resp, err := next(req)
+			if err == nil && resp != nil && resp.IsSuccess() {
+				cache.set(key, resp)
+			}
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package tushare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newEchoServer 创建返回固定数据并记录请求的模拟服务器
+func newEchoServer(t *testing.T, count *int32, check func(r *http.Request, params RequestParams)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(count, 1)
+
+		var reqParams RequestParams
+		if err := json.NewDecoder(r.Body).Decode(&reqParams); err != nil {
+			t.Errorf("解析请求体失败: %v", err)
+			return
+		}
+		if check != nil {
+			check(r, reqParams)
+		}
+
+		response := Response{
+			Code: 0,
+			Data: &ResponseData{
+				Fields:  []string{"ts_code"},
+				Items:   [][]interface{}{{"000001.SZ"}},
+				HasMore: false,
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestMiddleware_HeaderAndParams(t *testing.T) {
+	var count int32
+	server := newEchoServer(t, &count, func(r *http.Request, params RequestParams) {
+		if got := r.Header.Get("X-Request-ID"); got != "req-1" {
+			t.Errorf("期望请求头 X-Request-ID 为 req-1，但得到 %q", got)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("期望 Content-Type 为 application/json，但得到 %q", got)
+		}
+		if params.Params["src"] != "proxy" {
+			t.Errorf("期望中间件注入参数 src=proxy，但得到 %v", params.Params["src"])
+		}
+	})
+	defer server.Close()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *Request) (*Response, error) {
+				order = append(order, name)
+				return next(req)
+			}
+		}
+	}
+	inject := func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			req.Header.Set("X-Request-ID", "req-1")
+			req.Params.Params["src"] = "proxy"
+			return next(req)
+		}
+	}
+
+	client := NewClient("test_token",
+		WithHTTPURL(server.URL),
+		WithMiddleware(trace("a"), trace("b")),
+		WithMiddleware(inject),
+	)
+
+	if _, err := client.QueryOne("stock_basic", map[string]interface{}{}, ""); err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+	if strings.Join(order, ",") != "a,b" {
+		t.Errorf("期望中间件按添加顺序执行 a,b，但得到 %v", order)
+	}
+	if count != 1 {
+		t.Errorf("期望请求 1 次，但得到 %d", count)
+	}
+}
+
+func TestMiddleware_ShortCircuit(t *testing.T) {
+	var count int32
+	server := newEchoServer(t, &count, nil)
+	defer server.Close()
+
+	stub := func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			return &Response{
+				Code: 0,
+				Data: &ResponseData{
+					Fields: []string{"ts_code"},
+					Items:  [][]interface{}{{"600000.SH"}, {"600036.SH"}},
+				},
+			}, nil
+		}
+	}
+
+	client := NewClient("test_token", WithHTTPURL(server.URL), WithMiddleware(stub))
+	resp, err := client.Query("stock_basic", nil, "")
+	if err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+	if len(resp.Data.Items) != 2 {
+		t.Errorf("期望 2 条记录，但得到 %d", len(resp.Data.Items))
+	}
+	if count != 0 {
+		t.Errorf("短路后不应发出请求，但请求了 %d 次", count)
+	}
+}
+
+func TestMiddleware_ChaosRetried(t *testing.T) {
+	var count int32
+	server := newEchoServer(t, &count, nil)
+	defer server.Close()
+
+	// 模拟故障注入：第一次尝试返回网络错误
+	var attempts int32
+	chaos := func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			if atomic.AddInt32(&attempts, 1) == 1 {
+				return nil, errors.New("injected failure")
+			}
+			return next(req)
+		}
+	}
+
+	client := NewClient("test_token",
+		WithHTTPURL(server.URL),
+		WithRetryInterval(10*time.Millisecond),
+		WithMiddleware(chaos),
+	)
+	if _, err := client.QueryOne("stock_basic", nil, ""); err != nil {
+		t.Fatalf("期望重试后成功，但得到错误: %v", err)
+	}
+	if attempts != 2 || count != 1 {
+		t.Errorf("期望尝试 2 次、请求 1 次，但得到尝试 %d 次、请求 %d 次", attempts, count)
+	}
+}
+
+func TestMiddleware_NilResponse(t *testing.T) {
+	var count int32
+	server := newEchoServer(t, &count, nil)
+	defer server.Close()
+
+	// 错误的短路中间件：既不返回响应也不返回错误
+	broken := func(next Handler) Handler {
+		return func(req *Request) (*Response, error) {
+			return nil, nil
+		}
+	}
+
+	var buf bytes.Buffer
+	client := NewClient("test_token",
+		WithHTTPURL(server.URL),
+		WithRetryInterval(10*time.Millisecond),
+		WithMiddleware(LoggingMiddleware(log.New(&buf, "", 0)), broken),
+	)
+	if _, err := client.QueryOne("stock_basic", nil, ""); !errors.Is(err, ErrNilResponse) {
+		t.Errorf("QueryOne 期望 ErrNilResponse，但得到 %v", err)
+	}
+	if _, err := client.Query("stock_basic", nil, ""); !errors.Is(err, ErrNilResponse) {
+		t.Errorf("Query 期望 ErrNilResponse，但得到 %v", err)
+	}
+	if count != 0 {
+		t.Errorf("期望短路后不发送请求，但请求 %d 次", count)
+	}
+	if !strings.Contains(buf.String(), ErrNilResponse.Error()) {
+		t.Errorf("期望日志记录空响应: %s", buf.String())
+	}
+}
+
+func TestMiddleware_BuiltinCacheAndLogging(t *testing.T) {
+	var count int32
+	server := newEchoServer(t, &count, nil)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient("test_token",
+		WithHTTPURL(server.URL),
+		WithMiddleware(
+			LoggingMiddleware(log.New(&buf, "", 0)),
+			CacheMiddleware(time.Minute),
+		),
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Query("stock_basic", map[string]interface{}{"exchange": "SSE"}, "ts_code"); err != nil {
+			t.Fatalf("查询失败: %v", err)
+		}
+	}
+	if count != 1 {
+		t.Errorf("期望缓存命中后只请求 1 次，但请求 %d 次", count)
+	}
+	if lines := strings.Count(buf.String(), "api=stock_basic"); lines != 3 {
+		t.Errorf("期望记录 3 条日志，但得到 %d 条", lines)
+	}
+	if strings.Contains(buf.String(), "test_token") {
+		t.Error("日志中不应包含 Token")
+	}
+}
+
+func TestMiddleware_RetryAsMiddleware(t *testing.T) {
+	var attempts int
+	h := RetryMiddleware(RetryConfig{MaxRetries: 2, InitialDelay: time.Millisecond})(func(req *Request) (*Response, error) {
+		attempts++
+		return &Response{Code: CodeRateLimitExceeded, Msg: "超过调用频率"}, nil
+	})
+
+	req := &Request{Ctx: context.Background(), Header: make(http.Header)}
+	if _, err := h(req); err == nil {
+		t.Error("期望重试耗尽后返回错误，但没有")
+	}
+	if attempts != 3 {
+		t.Errorf("期望尝试 3 次（原始+2次重试），但得到 %d", attempts)
+	}
+}
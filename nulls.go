@@ -0,0 +1,180 @@
+package tushare
+
+import (
+	"fmt"
+	"math"
+)
+
+// IsNull 判断指定行和列是否为空值，列不存在或行号越界时返回 true
+// GetFloat64 等方法对空值返回零值，需要区分 0 和空值时使用该方法
+func (df *DataFrame) IsNull(row int, col string) bool {
+	s := df.Column(col)
+	return s == nil || row < 0 || row >= df.nrows || s.nulls[row]
+}
+
+// NullCounts 返回每列的空值个数
+func (df *DataFrame) NullCounts() map[string]int {
+	counts := make(map[string]int, len(df.series))
+	for _, s := range df.series {
+		counts[s.name] = s.NullCount()
+	}
+	return counts
+}
+
+// DropNA 删除 subset 中任一列为空值的行，subset 为空时检查所有列
+func (df *DataFrame) DropNA(subset ...string) (*DataFrame, error) {
+	series := df.series
+	if len(subset) > 0 {
+		var err error
+		if series, err = df.columns(subset); err != nil {
+			return nil, err
+		}
+	}
+
+	idx := make([]int, 0, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		if !hasNullKey(series, i) {
+			idx = append(idx, i)
+		}
+	}
+	return df.take(idx), nil
+}
+
+// FillNA 用 value 填充空值，只填充类型与 value 相符的列
+// （数值填充数值列，字符串填充字符串列，time.Time 填充日期列），其余列保持不变；
+// 带小数的值（如 1.5）无法无损写入 int64 列，这些列同样保持不变
+func (df *DataFrame) FillNA(value interface{}) *DataFrame {
+	typ := inferColumnType("", 1, func(int) interface{} { return value })
+
+	series := make([]*Series, len(df.series))
+	for j, s := range df.series {
+		series[j] = s
+		if value == nil || s.typ != typ && !(s.typ.isNumeric() && typ.isNumeric()) {
+			continue
+		}
+		if filled, err := fillNulls(s, value); err == nil {
+			series[j] = filled
+		}
+	}
+	result := newDataFrame(series)
+	result.nrows = df.nrows
+	return result
+}
+
+// FillNAMap 按列填充空值，values 的键为列名，值会转换为列的类型，无法无损转换时返回错误
+func (df *DataFrame) FillNAMap(values map[string]interface{}) (*DataFrame, error) {
+	series := make([]*Series, len(df.series))
+	copy(series, df.series)
+	for col, value := range values {
+		j, ok := df.index[col]
+		if !ok {
+			return nil, columnNotFound(col)
+		}
+		filled, err := fillNulls(df.series[j], value)
+		if err != nil {
+			return nil, err
+		}
+		series[j] = filled
+	}
+	result := newDataFrame(series)
+	result.nrows = df.nrows
+	return result, nil
+}
+
+// fillNulls 返回用 value 填充空值后的列
+func fillNulls(s *Series, value interface{}) (*Series, error) {
+	fill := newSeries(s.name, s.typ, 1)
+	fill.appendValue(value)
+	if fill.nulls[0] || s.typ == ColumnInt64 && !isWholeNumber(value) {
+		return nil, fmt.Errorf("cannot fill column %s (%s) with %v", s.name, s.typ, value)
+	}
+	if s.NullCount() == 0 {
+		return s, nil
+	}
+
+	result := newSeries(s.name, s.typ, s.Len())
+	for i := range s.nulls {
+		if s.nulls[i] {
+			result.appendFrom(fill, 0)
+		} else {
+			result.appendFrom(s, i)
+		}
+	}
+	return result, nil
+}
+
+// isWholeNumber 判断 value 是否没有小数部分，非数值返回 true（由列类型转换决定能否填充）
+func isWholeNumber(value interface{}) bool {
+	f, ok := toFloat64(value)
+	return !ok || f == math.Trunc(f)
+}
+
+// FFill 按行顺序用前一个非空值填充指定列的空值，cols 为空时填充所有列
+// 需要按股票分组、按日期排序时使用 Window.FFill
+func (df *DataFrame) FFill(cols ...string) (*DataFrame, error) {
+	return df.fillAlong(cols, (*Window).FFill)
+}
+
+// BFill 按行顺序用后一个非空值填充指定列的空值，cols 为空时填充所有列
+// 需要按股票分组、按日期排序时使用 Window.BFill
+func (df *DataFrame) BFill(cols ...string) (*DataFrame, error) {
+	return df.fillAlong(cols, (*Window).BFill)
+}
+
+// fillAlong 在整个 DataFrame 上依次对各列执行窗口填充
+func (df *DataFrame) fillAlong(cols []string, fill func(w *Window, col string) (*Series, error)) (*DataFrame, error) {
+	if len(cols) == 0 {
+		cols = df.Columns
+	}
+	w, err := df.Window(WindowSpec{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := df
+	for _, col := range cols {
+		s, err := fill(w, col)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = result.WithColumn(s); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// FFill 在分区内按顺序用前一个非空值填充空值，保留原列类型
+// 例如将季度财务数据对齐到日线后，按 ts_code 分区、trade_date 排序向前填充
+func (w *Window) FFill(col string) (*Series, error) {
+	return w.fill(col, false)
+}
+
+// BFill 在分区内按顺序用后一个非空值填充空值，保留原列类型
+func (w *Window) BFill(col string) (*Series, error) {
+	return w.fill(col, true)
+}
+
+// fill 按分区顺序（backward 为 true 时逆序）用最近的非空值填充空值
+func (w *Window) fill(col string, backward bool) (*Series, error) {
+	s := w.df.Column(col)
+	if s == nil {
+		return nil, columnNotFound(col)
+	}
+
+	idx := make([]int, w.df.nrows)
+	for _, rows := range w.partitions {
+		last := -1
+		for k := range rows {
+			if backward {
+				k = len(rows) - 1 - k
+			}
+			i := rows[k]
+			if !s.nulls[i] {
+				last = i
+			}
+			idx[i] = last
+		}
+	}
+	return s.take(idx), nil
+}
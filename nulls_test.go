@@ -0,0 +1,160 @@
+package tushare
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// newSparseFrame 日线对齐季度数据后的稀疏 DataFrame（两只股票、日期乱序）
+func newSparseFrame(t *testing.T) *DataFrame {
+	t.Helper()
+	return newTestFrame(t,
+		NewStringSeries("ts_code", []string{"A", "B", "A", "A", "B", "B"}, nil),
+		NewStringSeries("trade_date", []string{"20240103", "20240101", "20240101", "20240102", "20240103", "20240102"}, nil),
+		NewFloat64Series("eps", []float64{0, 0.5, 0.3, 0, 0, 0}, []bool{true, false, false, true, true, true}),
+		NewStringSeries("note", []string{"", "x", "", "y", "", ""}, []bool{true, false, true, false, true, true}),
+	)
+}
+
+func TestDataFrame_IsNullAndCounts(t *testing.T) {
+	df := newSparseFrame(t)
+
+	if !df.IsNull(0, "eps") || df.IsNull(1, "eps") {
+		t.Error("IsNull 结果不正确")
+	}
+	if df.GetFloat64(0, "eps") != 0 {
+		t.Error("期望空值的 GetFloat64 返回 0")
+	}
+	if !df.IsNull(0, "missing") || !df.IsNull(100, "eps") {
+		t.Error("期望列不存在或行号越界时返回 true")
+	}
+
+	counts := df.NullCounts()
+	if counts["ts_code"] != 0 || counts["eps"] != 4 || counts["note"] != 4 {
+		t.Errorf("空值计数不正确: %v", counts)
+	}
+}
+
+func TestDataFrame_DropNA(t *testing.T) {
+	df := newSparseFrame(t)
+
+	result, err := df.DropNA("eps")
+	if err != nil {
+		t.Fatalf("DropNA 失败: %v", err)
+	}
+	assertStrings(t, columnStrings(result, "trade_date"), []string{"20240101", "20240101"})
+
+	// 不指定列时任一列为空值即删除
+	result, _ = df.DropNA()
+	assertStrings(t, columnStrings(result, "ts_code"), []string{"B"})
+
+	if _, err := df.DropNA("missing"); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+}
+
+func TestDataFrame_FillNA(t *testing.T) {
+	df := newSparseFrame(t)
+
+	// 数值只填充数值列
+	result := df.FillNA(0)
+	if result.NullCounts()["eps"] != 0 || result.NullCounts()["note"] != 4 {
+		t.Errorf("期望只填充 eps 列，但得到 %v", result.NullCounts())
+	}
+	if result.Column("eps").Type() != ColumnFloat64 {
+		t.Errorf("期望保留 float64 类型，但得到 %s", result.Column("eps").Type())
+	}
+
+	result = df.FillNA("-")
+	assertStrings(t, columnStrings(result, "note"), []string{"-", "x", "-", "y", "-", "-"})
+	if result.NullCounts()["eps"] != 4 {
+		t.Error("期望字符串不填充数值列")
+	}
+
+	result, err := df.FillNAMap(map[string]interface{}{"eps": "1.5", "note": "无"})
+	if err != nil {
+		t.Fatalf("FillNAMap 失败: %v", err)
+	}
+	if result.GetFloat64(0, "eps") != 1.5 || result.GetString(0, "note") != "无" {
+		t.Errorf("FillNAMap 结果不正确: %v %v", result.GetFloat64(0, "eps"), result.GetString(0, "note"))
+	}
+	// 原 DataFrame 不受影响
+	if !df.IsNull(0, "eps") {
+		t.Error("期望原 DataFrame 不被修改")
+	}
+
+	if _, err := df.FillNAMap(map[string]interface{}{"eps": "abc"}); err == nil {
+		t.Error("期望无法转换的值返回错误")
+	}
+	if _, err := df.FillNAMap(map[string]interface{}{"missing": 1}); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+}
+
+func TestDataFrame_FillNAInt64(t *testing.T) {
+	df := newTestFrame(t,
+		NewInt64Series("vol", []int64{100, 0}, []bool{false, true}),
+		NewFloat64Series("eps", []float64{0.3, 0}, []bool{false, true}),
+	)
+
+	// 带小数的值不能无损写入 int64 列，FillNA 跳过该列
+	result := df.FillNA(1.5)
+	if !result.IsNull(1, "vol") || result.GetFloat64(1, "eps") != 1.5 {
+		t.Errorf("期望只填充 eps 列，但得到 %v", result.Records())
+	}
+	result = df.FillNA(2.0)
+	if result.GetInt(1, "vol") != 2 || result.Column("vol").Type() != ColumnInt64 {
+		t.Errorf("期望整数值填充 int64 列，但得到 %v", result.Records())
+	}
+
+	for _, value := range []interface{}{1.5, "1.5"} {
+		if _, err := df.FillNAMap(map[string]interface{}{"vol": value}); err == nil {
+			t.Errorf("期望 %v 填充 int64 列时返回错误", value)
+		}
+	}
+}
+
+func TestWindow_FFillBFill(t *testing.T) {
+	df := newSparseFrame(t)
+	w, _ := df.Window(WindowSpec{PartitionBy: "ts_code", OrderBy: "trade_date"})
+
+	// A：0101=0.3, 0102=null, 0103=null；B：0101=0.5, 0102=null, 0103=null
+	ffill, err := w.FFill("eps")
+	if err != nil {
+		t.Fatalf("FFill 失败: %v", err)
+	}
+	assertFloats(t, ffill, []float64{0.3, 0.5, 0.3, 0.3, 0.5, 0.5})
+
+	// A：0101=null, 0102=y, 0103=null；B：0101=x, 0102=null, 0103=null
+	bfill, _ := w.BFill("note")
+	if bfill.Type() != ColumnString {
+		t.Errorf("期望保留字符串类型，但得到 %s", bfill.Type())
+	}
+	want := []string{"", "x", "y", "y", "", ""}
+	wantNull := []bool{true, false, false, false, true, true}
+	for i := range want {
+		if bfill.IsNull(i) != wantNull[i] || bfill.String(i) != want[i] {
+			t.Errorf("第 %d 行期望 %q（空值: %v），但得到 %q（空值: %v）", i, want[i], wantNull[i], bfill.String(i), bfill.IsNull(i))
+		}
+	}
+}
+
+func TestDataFrame_FFillBFill(t *testing.T) {
+	df := newSparseFrame(t)
+
+	// 不分组时按行顺序填充
+	result, err := df.FFill("eps")
+	if err != nil {
+		t.Fatalf("FFill 失败: %v", err)
+	}
+	assertFloats(t, result.Column("eps"), []float64{math.NaN(), 0.5, 0.3, 0.3, 0.3, 0.3})
+
+	result, _ = df.BFill()
+	assertStrings(t, columnStrings(result, "note"), []string{"x", "x", "y", "y", "", ""})
+	assertFloats(t, result.Column("eps"), []float64{0.5, 0.5, 0.3, math.NaN(), math.NaN(), math.NaN()})
+
+	if _, err := df.FFill("missing"); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+}
@@ -0,0 +1,246 @@
+package tushare
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 默认的表格显示限制
+const (
+	DefaultPrintMaxRows     = 20
+	DefaultPrintMaxCols     = 20
+	DefaultPrintMaxColWidth = 30
+)
+
+// printNull 空值的显示文本
+const printNull = "null"
+
+// printEllipsis 省略的行、列和截断内容的显示文本
+const printEllipsis = "..."
+
+// PrintOptions 表格显示选项，nil 表示使用默认值
+type PrintOptions struct {
+	MaxRows     int // 最多显示的行数，超出时显示首尾各一半并以 ... 分隔；0 表示默认值 20，负数表示不限制
+	MaxCols     int // 最多显示的列数，规则同 MaxRows，默认 20
+	MaxColWidth int // 单元格最大显示宽度，超出时截断并以 ... 结尾；0 表示默认值 30，负数表示不限制
+	Precision   int // 浮点数保留的小数位数，0 表示使用能精确表示数值的最短形式
+}
+
+// String 以对齐的表格形式返回 DataFrame（使用默认的显示选项）
+func (df *DataFrame) String() string {
+	var buf bytes.Buffer
+	df.Print(&buf, nil)
+	return buf.String()
+}
+
+// Print 以对齐的表格形式输出 DataFrame
+//
+// 第一列为行号；数值列右对齐，其他列左对齐；中文等全角字符按两个字符宽度对齐。
+// 行或列被省略时，在末尾输出 [N rows x M columns]。
+func (df *DataFrame) Print(w io.Writer, opts *PrintOptions) error {
+	if opts == nil {
+		opts = &PrintOptions{}
+	}
+	maxRows := limitOrDefault(opts.MaxRows, DefaultPrintMaxRows)
+	maxCols := limitOrDefault(opts.MaxCols, DefaultPrintMaxCols)
+	maxWidth := limitOrDefault(opts.MaxColWidth, DefaultPrintMaxColWidth)
+
+	rows, rowsCut := elideRange(df.nrows, maxRows)
+	cols, colsCut := elideRange(len(df.series), maxCols)
+
+	// 每列的单元格文本，第一行为表头
+	index := make([]string, 0, len(rows)+1)
+	index = append(index, "")
+	for _, i := range rows {
+		if i < 0 {
+			index = append(index, printEllipsis)
+		} else {
+			index = append(index, strconv.Itoa(i))
+		}
+	}
+	table := []printColumn{{cells: index, right: true}}
+
+	for _, j := range cols {
+		if j < 0 {
+			cells := make([]string, len(rows)+1)
+			for k := range cells {
+				cells[k] = printEllipsis
+			}
+			table = append(table, printColumn{cells: cells})
+			continue
+		}
+
+		s := df.series[j]
+		cells := make([]string, 0, len(rows)+1)
+		cells = append(cells, truncateWidth(s.name, maxWidth))
+		for _, i := range rows {
+			switch {
+			case i < 0:
+				cells = append(cells, printEllipsis)
+			case s.nulls[i]:
+				cells = append(cells, printNull)
+			default:
+				cells = append(cells, truncateWidth(printCell(s, i, opts.Precision), maxWidth))
+			}
+		}
+		table = append(table, printColumn{cells: cells, right: s.typ.isNumeric()})
+	}
+
+	for c := range table {
+		table[c].measure()
+	}
+
+	var line strings.Builder
+	for k := 0; k <= len(rows); k++ {
+		line.Reset()
+		for c := range table {
+			if c > 0 {
+				line.WriteString("  ")
+			}
+			table[c].pad(&line, k)
+		}
+		if _, err := io.WriteString(w, strings.TrimRight(line.String(), " ")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	if rowsCut || colsCut {
+		if _, err := fmt.Fprintf(w, "\n[%d rows x %d columns]\n", df.nrows, len(df.series)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printColumn 待输出的一列
+type printColumn struct {
+	cells  []string
+	widths []int
+	width  int
+	right  bool
+}
+
+// measure 计算每个单元格及整列的显示宽度
+func (c *printColumn) measure() {
+	c.widths = make([]int, len(c.cells))
+	for k, cell := range c.cells {
+		c.widths[k] = displayWidth(cell)
+		c.width = max(c.width, c.widths[k])
+	}
+}
+
+// pad 按对齐方式输出第 k 个单元格，并补齐到列宽
+func (c *printColumn) pad(b *strings.Builder, k int) {
+	padding := strings.Repeat(" ", c.width-c.widths[k])
+	if c.right {
+		b.WriteString(padding)
+		b.WriteString(c.cells[k])
+		return
+	}
+	b.WriteString(c.cells[k])
+	b.WriteString(padding)
+}
+
+// printCell 以显示文本返回第 i 个非空元素
+func printCell(s *Series, i int, precision int) string {
+	if s.typ != ColumnFloat64 {
+		return s.String(i)
+	}
+	if precision > 0 {
+		return strconv.FormatFloat(s.floats[i], 'f', precision, 64)
+	}
+	return formatCell(s, i)
+}
+
+// limitOrDefault 0 返回默认值，负数表示不限制
+func limitOrDefault(n, def int) int {
+	switch {
+	case n == 0:
+		return def
+	case n < 0:
+		return -1
+	default:
+		return n
+	}
+}
+
+// elideRange 返回 [0, n) 中需要显示的序号，超出 limit 时保留首尾并以 -1 表示省略位置
+func elideRange(n, limit int) ([]int, bool) {
+	if limit < 0 || n <= limit {
+		return rowRange(0, n), false
+	}
+	head := (limit + 1) / 2
+	tail := limit - head
+	idx := make([]int, 0, limit+1)
+	idx = append(idx, rowRange(0, head)...)
+	idx = append(idx, -1)
+	idx = append(idx, rowRange(n-tail, n)...)
+	return idx, true
+}
+
+// ==================== 显示宽度 ====================
+
+// displayWidth 返回字符串在等宽终端中的显示宽度，全角字符计为 2，组合字符计为 0
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// truncateWidth 将字符串截断到 maxWidth 显示宽度以内，截断时以 ... 结尾；maxWidth < 0 表示不截断
+// maxWidth 容不下省略号时只保留开头的字符
+func truncateWidth(s string, maxWidth int) string {
+	if maxWidth < 0 || displayWidth(s) <= maxWidth {
+		return s
+	}
+	limit, ellipsis := maxWidth-len(printEllipsis), printEllipsis
+	if limit < 0 {
+		limit, ellipsis = maxWidth, ""
+	}
+	width := 0
+	for k, r := range s {
+		w := runeWidth(r)
+		if width+w > limit {
+			return s[:k] + ellipsis
+		}
+		width += w
+	}
+	return s
+}
+
+// runeWidth 返回单个字符的显示宽度
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || r == 0x7F:
+		return 0
+	case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || r == 0x200B:
+		return 0
+	case isWideRune(r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// isWideRune 判断是否为东亚全角字符（中日韩文字、全角标点和符号）
+func isWideRune(r rune) bool {
+	return (r >= 0x1100 && r <= 0x115F) || // 谚文字母
+		(r >= 0x2E80 && r <= 0x303E) || // 中日韩部首、标点
+		(r >= 0x3041 && r <= 0x33FF) || // 假名、注音、中日韩兼容字符
+		(r >= 0x3400 && r <= 0x4DBF) || // 中日韩统一表意文字扩展 A
+		(r >= 0x4E00 && r <= 0x9FFF) || // 中日韩统一表意文字
+		(r >= 0xA000 && r <= 0xA4CF) || // 彝文
+		(r >= 0xAC00 && r <= 0xD7A3) || // 谚文音节
+		(r >= 0xF900 && r <= 0xFAFF) || // 中日韩兼容表意文字
+		(r >= 0xFE30 && r <= 0xFE4F) || // 中日韩兼容形式
+		(r >= 0xFF00 && r <= 0xFF60) || // 全角字符
+		(r >= 0xFFE0 && r <= 0xFFE6) || // 全角符号
+		(r >= 0x1F300 && r <= 0x1F64F) || // 表情符号
+		(r >= 0x20000 && r <= 0x3FFFD) // 中日韩统一表意文字扩展 B 及以后
+}
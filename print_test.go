@@ -0,0 +1,130 @@
+package tushare
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// newNameFrame 含中文名称和空值的 DataFrame
+func newNameFrame(t *testing.T) *DataFrame {
+	t.Helper()
+	return newTestFrame(t,
+		NewStringSeries("ts_code", []string{"000001.SZ", "000002.SZ", "600519.SH"}, nil),
+		NewStringSeries("name", []string{"平安银行", "万科A", "贵州茅台"}, nil),
+		NewFloat64Series("close", []float64{9.39, 10.5, 1700}, []bool{false, true, false}),
+		NewInt64Series("vol", []int64{100, 2000, 3}, nil),
+	)
+}
+
+func TestDataFrame_String(t *testing.T) {
+	want := "" +
+		"   ts_code    name      close   vol\n" +
+		"0  000001.SZ  平安银行   9.39   100\n" +
+		"1  000002.SZ  万科A      null  2000\n" +
+		"2  600519.SH  贵州茅台   1700     3\n"
+	if got := newNameFrame(t).String(); got != want {
+		t.Errorf("期望:\n%s\n但得到:\n%s", want, got)
+	}
+}
+
+func TestDataFrame_PrintOptions(t *testing.T) {
+	var b strings.Builder
+	err := newNameFrame(t).Print(&b, &PrintOptions{MaxRows: 2, MaxCols: 2, MaxColWidth: 6, Precision: 2})
+	if err != nil {
+		t.Fatalf("Print 失败: %v", err)
+	}
+
+	want := "" +
+		"     ts_...  ...  vol\n" +
+		"  0  000...  ...  100\n" +
+		"...  ...     ...  ...\n" +
+		"  2  600...  ...    3\n" +
+		"\n" +
+		"[3 rows x 4 columns]\n"
+	if b.String() != want {
+		t.Errorf("期望:\n%s\n但得到:\n%s", want, b.String())
+	}
+
+	b.Reset()
+	newNameFrame(t).Print(&b, &PrintOptions{MaxRows: -1, Precision: 1})
+	if !strings.Contains(b.String(), "1700.0") || strings.Contains(b.String(), "rows x") {
+		t.Errorf("期望固定 1 位小数且不省略，但得到:\n%s", b.String())
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+	}{
+		{"abc", 3},
+		{"平安银行", 8},
+		{"万科A", 5},
+		{"（全角）", 8},
+		{"é", 1}, // 组合字符不占宽度
+	}
+	for _, tt := range tests {
+		if got := displayWidth(tt.s); got != tt.width {
+			t.Errorf("displayWidth(%q) 期望 %d，但得到 %d", tt.s, tt.width, got)
+		}
+	}
+
+	// 截断不会把全角字符拆开
+	if got := truncateWidth("平安银行股份", 8); got != "平安..." {
+		t.Errorf("期望截断为 平安...，但得到 %s", got)
+	}
+
+	// 宽度容不下省略号时不超出限制
+	for maxWidth, want := range map[int]string{0: "", 1: "a", 2: "ab", 3: "..."} {
+		if got := truncateWidth("abcdef", maxWidth); got != want {
+			t.Errorf("truncateWidth(abcdef, %d) 期望 %q，但得到 %q", maxWidth, want, got)
+		}
+	}
+	if got := truncateWidth("平安银行", 1); got != "" {
+		t.Errorf("期望宽度 1 容不下全角字符，但得到 %q", got)
+	}
+}
+
+func TestDataFrame_Describe(t *testing.T) {
+	desc := newNameFrame(t).Describe()
+
+	// 非数值列被忽略
+	assertStrings(t, desc.Columns, []string{DescribeStatColumn, "close", "vol"})
+	assertStrings(t, columnStrings(desc, DescribeStatColumn), []string{"count", "null", "mean", "std", "min", "25%", "50%", "75%", "max"})
+
+	closes := desc.Column("close")
+	want := []float64{2, 1, 854.695, math.Sqrt(2 * 845.305 * 845.305), 9.39, 432.0425, 854.695, 1277.3475, 1700}
+	for i, w := range want {
+		if got, _ := closes.Float64(i); math.Abs(got-w) > 1e-6 {
+			t.Errorf("close 的 %s 期望 %f，但得到 %f", desc.GetString(i, DescribeStatColumn), w, got)
+		}
+	}
+	if got := desc.GetFloat64(6, "vol"); got != 100 {
+		t.Errorf("期望 vol 中位数为 100，但得到 %f", got)
+	}
+
+	// 只有一个有效值时标准差为空值，全部为空时统计项为空值
+	df := newTestFrame(t,
+		NewFloat64Series("one", []float64{1, 0}, []bool{false, true}),
+		NewFloat64Series("none", []float64{0, 0}, []bool{true, true}),
+	)
+	desc = df.Describe()
+	if !desc.Column("one").IsNull(3) || desc.Column("one").IsNull(4) {
+		t.Error("期望 one 的 std 为空值、min 不为空值")
+	}
+	if desc.GetFloat64(1, "none") != 2 || !desc.Column("none").IsNull(2) {
+		t.Error("期望 none 的 null 为 2、mean 为空值")
+	}
+
+	// 统计项列与数值列重名时改名，不覆盖数值列
+	df = newTestFrame(t,
+		NewFloat64Series("stat", []float64{1, 2}, nil),
+		NewFloat64Series("_stat", []float64{3, 4}, nil),
+	)
+	desc = df.Describe()
+	assertStrings(t, desc.Columns, []string{"__stat", "stat", "_stat"})
+	if desc.GetString(2, "__stat") != "mean" || desc.GetFloat64(2, "stat") != 1.5 || desc.GetFloat64(2, "_stat") != 3.5 {
+		t.Errorf("重名时统计结果不正确: %v", desc.Records())
+	}
+}
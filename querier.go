@@ -0,0 +1,276 @@
+package tushare
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Querier 数据查询接口
+// *Client 实现了该接口，各业务包的接口函数均接收 Querier，
+// 便于注入测试替身或使用缓存、日志、限频等装饰器
+type Querier interface {
+	// Query 执行通用查询（自动处理分页）
+	Query(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error)
+	// QueryOne 执行单次查询（不处理分页）
+	QueryOne(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error)
+}
+
+var _ Querier = (*Client)(nil)
+
+// queryFunc Query/QueryOne 的函数签名
+type queryFunc func(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error)
+
+// Logger 日志接口，*log.Logger 实现了该接口
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// ==================== 缓存装饰器 ====================
+
+// cacheEntry 缓存项
+type cacheEntry struct {
+	resp    *Response
+	expires time.Time
+}
+
+// responseCache 带过期时间的响应缓存
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+// newResponseCache 创建响应缓存，ttl <= 0 表示永不过期
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get 获取未过期的缓存
+func (c *responseCache) get(key string) (*Response, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.ttl > 0 && time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.resp, true
+}
+
+// set 写入缓存
+func (c *responseCache) set(key string, resp *Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		resp:    resp,
+		expires: time.Now().Add(c.ttl),
+	}
+}
+
+// purge 清空缓存
+func (c *responseCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]cacheEntry)
+}
+
+// cacheKey 生成缓存键（encoding/json 会对 map 的键排序，保证相同参数得到相同的键）
+func cacheKey(method, apiName string, params map[string]interface{}, fields string) (string, bool) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	return method + "|" + apiName + "|" + fields + "|" + string(data), true
+}
+
+// CachingQuerier 缓存查询结果的装饰器
+// 只缓存成功的响应，相同的接口名、参数和字段在有效期内直接返回缓存结果。
+// 返回的 *Response 在多次调用间共享，调用方不应修改其内容。
+type CachingQuerier struct {
+	next  Querier
+	cache *responseCache
+}
+
+// NewCachingQuerier 创建缓存装饰器，ttl <= 0 表示永不过期
+func NewCachingQuerier(next Querier, ttl time.Duration) *CachingQuerier {
+	return &CachingQuerier{
+		next:  next,
+		cache: newResponseCache(ttl),
+	}
+}
+
+// Query 执行通用查询（优先使用缓存）
+func (q *CachingQuerier) Query(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	return q.cached("Query", q.next.Query, apiName, params, fields, opts)
+}
+
+// QueryOne 执行单次查询（优先使用缓存）
+func (q *CachingQuerier) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	return q.cached("QueryOne", q.next.QueryOne, apiName, params, fields, opts)
+}
+
+// Purge 清空缓存
+func (q *CachingQuerier) Purge() {
+	q.cache.purge()
+}
+
+// cached 查询缓存，未命中时调用下游并缓存成功的响应
+func (q *CachingQuerier) cached(method string, query queryFunc, apiName string, params map[string]interface{}, fields string, opts []QueryOption) (*Response, error) {
+	key, ok := cacheKey(method, apiName, params, fields)
+	if !ok {
+		return query(apiName, params, fields, opts...)
+	}
+
+	if resp, hit := q.cache.get(key); hit {
+		return resp, nil
+	}
+
+	resp, err := query(apiName, params, fields, opts...)
+	if err == nil && resp != nil && resp.IsSuccess() {
+		q.cache.set(key, resp)
+	}
+	return resp, err
+}
+
+// ==================== 日志装饰器 ====================
+
+// LoggingQuerier 记录每次查询的接口名、参数、返回行数和耗时的装饰器
+type LoggingQuerier struct {
+	next   Querier
+	logger Logger
+}
+
+// NewLoggingQuerier 创建日志装饰器
+func NewLoggingQuerier(next Querier, logger Logger) *LoggingQuerier {
+	return &LoggingQuerier{
+		next:   next,
+		logger: logger,
+	}
+}
+
+// Query 执行通用查询并记录日志
+func (q *LoggingQuerier) Query(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	return q.logged("Query", q.next.Query, apiName, params, fields, opts)
+}
+
+// QueryOne 执行单次查询并记录日志
+func (q *LoggingQuerier) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	return q.logged("QueryOne", q.next.QueryOne, apiName, params, fields, opts)
+}
+
+// logged 调用下游并记录日志
+func (q *LoggingQuerier) logged(method string, query queryFunc, apiName string, params map[string]interface{}, fields string, opts []QueryOption) (*Response, error) {
+	start := time.Now()
+	resp, err := query(apiName, params, fields, opts...)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		q.logger.Printf("tushare %s api=%s params=%v fields=%q elapsed=%v error=%v", method, apiName, params, fields, elapsed, err)
+		return resp, err
+	}
+
+	rows := 0
+	if resp != nil && resp.Data != nil {
+		rows = len(resp.Data.Items)
+	}
+	q.logger.Printf("tushare %s api=%s params=%v fields=%q elapsed=%v rows=%d", method, apiName, params, fields, elapsed, rows)
+	return resp, err
+}
+
+// ==================== 限频装饰器 ====================
+
+// RateLimitedQuerier 按优先级限频的装饰器，优先级通过 WithPriority 查询选项指定
+//
+// 下游为 *Client（可经过其他转发查询选项的装饰器）时，每次 HTTP 请求（包括分页和重试）消耗一个配额，
+// 与 Tushare 按请求次数计算的频率限制一致；其他 Querier 无法感知分页，每次 Query/QueryOne 调用消耗一个配额。
+type RateLimitedQuerier struct {
+	next      Querier
+	scheduler *scheduler
+}
+
+// NewRateLimitedQuerier 创建限频装饰器，perMinute 为每分钟最大调用次数，<= 0 表示不限频
+func NewRateLimitedQuerier(next Querier, perMinute int) *RateLimitedQuerier {
+	return &RateLimitedQuerier{
+		next:      next,
+		scheduler: newSchedulerPerMinute(perMinute, DefaultMaxStarvation),
+	}
+}
+
+// Query 获取配额后执行通用查询，分页请求逐页获取配额
+func (q *RateLimitedQuerier) Query(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	opts, err := q.wait(opts)
+	if err != nil {
+		return nil, err
+	}
+	return q.next.Query(apiName, params, fields, opts...)
+}
+
+// QueryOne 获取配额后执行单次查询，重试请求同样获取配额
+func (q *RateLimitedQuerier) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	opts, err := q.wait(opts)
+	if err != nil {
+		return nil, err
+	}
+	return q.next.QueryOne(apiName, params, fields, opts...)
+}
+
+// Stats 返回调度统计
+func (q *RateLimitedQuerier) Stats() SchedulerStats {
+	if q.scheduler == nil {
+		return SchedulerStats{ByPriority: map[Priority]PriorityStats{}}
+	}
+	return q.scheduler.snapshot()
+}
+
+// wait 按查询选项中的优先级预先获取第一个请求的配额，并返回附加了限频关卡的查询选项
+func (q *RateLimitedQuerier) wait(opts []QueryOption) ([]QueryOption, error) {
+	if q.scheduler == nil {
+		return opts, nil
+	}
+	options := applyQueryOptions(opts)
+	if err := q.scheduler.acquire(options.ctx, options.priority); err != nil {
+		return nil, err
+	}
+	gate := &requestGate{scheduler: q.scheduler, prepaid: true}
+	return append(opts[:len(opts):len(opts)], withRequestGate(gate)), nil
+}
+
+// requestGate RateLimitedQuerier 随查询选项传给 *Client 的限频关卡，每次 HTTP 请求通过一次
+//
+// 第一次通过时使用调用 Query/QueryOne 时预先获取的配额（下游不是 *Client 时只会消耗这一个配额），
+// 之后的每次请求重新获取配额。
+type requestGate struct {
+	scheduler *scheduler
+	mu        sync.Mutex
+	prepaid   bool
+}
+
+// withRequestGate 添加限频关卡
+func withRequestGate(g *requestGate) QueryOption {
+	return func(o *queryOptions) {
+		o.gates = append(o.gates, g)
+	}
+}
+
+// take 通过关卡：优先使用预先获取的配额，否则等待新的配额
+func (g *requestGate) take(ctx context.Context, p Priority) error {
+	g.mu.Lock()
+	prepaid := g.prepaid
+	g.prepaid = false
+	g.mu.Unlock()
+	if prepaid {
+		return nil
+	}
+	return g.scheduler.acquire(ctx, p)
+}
@@ -0,0 +1,162 @@
+package tushare
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeQuerier 用于测试的 Querier 替身
+type fakeQuerier struct {
+	calls int
+	resp  *Response
+	err   error
+}
+
+func (f *fakeQuerier) Query(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	f.calls++
+	return f.resp, f.err
+}
+
+func (f *fakeQuerier) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...QueryOption) (*Response, error) {
+	f.calls++
+	return f.resp, f.err
+}
+
+func newFakeQuerier() *fakeQuerier {
+	return &fakeQuerier{
+		resp: &Response{
+			Code: 0,
+			Data: &ResponseData{
+				Fields: []string{"ts_code", "name"},
+				Items: [][]interface{}{
+					{"000001.SZ", "平安银行"},
+					{"000002.SZ", "万科A"},
+				},
+			},
+		},
+	}
+}
+
+func TestCachingQuerier(t *testing.T) {
+	fake := newFakeQuerier()
+	q := NewCachingQuerier(fake, time.Minute)
+
+	params := map[string]interface{}{"list_status": "L", "exchange": "SZSE"}
+	for i := 0; i < 3; i++ {
+		resp, err := q.Query("stock_basic", params, "ts_code,name")
+		if err != nil {
+			t.Fatalf("查询失败: %v", err)
+		}
+		if len(resp.Data.Items) != 2 {
+			t.Errorf("期望 2 条记录，但得到 %d", len(resp.Data.Items))
+		}
+	}
+	if fake.calls != 1 {
+		t.Errorf("期望下游只调用 1 次，但调用 %d 次", fake.calls)
+	}
+
+	// 不同参数不命中缓存
+	if _, err := q.Query("stock_basic", map[string]interface{}{"list_status": "D"}, "ts_code,name"); err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+	// QueryOne 与 Query 分开缓存
+	if _, err := q.QueryOne("stock_basic", params, "ts_code,name"); err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+	if fake.calls != 3 {
+		t.Errorf("期望下游调用 3 次，但调用 %d 次", fake.calls)
+	}
+
+	q.Purge()
+	if _, err := q.Query("stock_basic", params, "ts_code,name"); err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+	if fake.calls != 4 {
+		t.Errorf("清空缓存后期望下游调用 4 次，但调用 %d 次", fake.calls)
+	}
+}
+
+func TestCachingQuerier_SkipErrors(t *testing.T) {
+	fake := newFakeQuerier()
+	fake.err = &APIError{Code: 2002, Msg: "没有权限"}
+	q := NewCachingQuerier(fake, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, err := q.Query("stock_basic", nil, ""); err == nil {
+			t.Fatal("期望返回错误，但没有")
+		}
+	}
+	if fake.calls != 2 {
+		t.Errorf("错误响应不应被缓存，期望下游调用 2 次，但调用 %d 次", fake.calls)
+	}
+}
+
+func TestLoggingQuerier(t *testing.T) {
+	var buf bytes.Buffer
+	q := NewLoggingQuerier(newFakeQuerier(), log.New(&buf, "", 0))
+
+	if _, err := q.Query("stock_basic", map[string]interface{}{"exchange": "SSE"}, "ts_code"); err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"api=stock_basic", "exchange:SSE", "rows=2"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("日志中缺少 %q: %s", want, out)
+		}
+	}
+}
+
+func TestRateLimitedQuerier(t *testing.T) {
+	fake := newFakeQuerier()
+	// 每分钟 3000 次，即每 20ms 放行一次；装饰器可以任意组合
+	q := NewRateLimitedQuerier(NewCachingQuerier(fake, 0), 3000)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := q.Query("daily", nil, "", WithPriority(PriorityLow)); err != nil {
+			t.Fatalf("查询失败: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("期望限频生效（至少 40ms），但只用了 %v", elapsed)
+	}
+	if granted := q.Stats().ByPriority[PriorityLow].Granted; granted != 3 {
+		t.Errorf("期望低优先级放行 3 次，但得到 %d", granted)
+	}
+	if fake.calls != 1 {
+		t.Errorf("期望下游只调用 1 次，但调用 %d 次", fake.calls)
+	}
+}
+
+func TestRateLimitedQuerier_PerPage(t *testing.T) {
+	pages := [][][]interface{}{
+		{{"000001.SZ", "平安银行", 10.5}, {"000002.SZ", "万科A", 8.2}},
+		{{"600000.SH", "浦发银行", 7.1}, {"600036.SH", "招商银行", 30.2}},
+		{{"000651.SZ", "格力电器", 35.0}},
+	}
+	server := newPagedServer(t, pages, -1)
+	defer server.Close()
+
+	client := NewClient("test_token", WithHTTPURL(server.URL), WithLimit(2))
+	// 经过其他装饰器后，分页产生的每次 HTTP 请求仍各消耗一个配额
+	q := NewRateLimitedQuerier(NewLoggingQuerier(client, log.New(&bytes.Buffer{}, "", 0)), 3000)
+
+	start := time.Now()
+	resp, err := q.Query("stock_basic", nil, "")
+	if err != nil {
+		t.Fatalf("查询失败: %v", err)
+	}
+	if len(resp.Data.Items) != 5 {
+		t.Errorf("期望 5 条数据，但得到 %d", len(resp.Data.Items))
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("期望逐页限频（至少 40ms），但只用了 %v", elapsed)
+	}
+	if granted := q.Stats().ByPriority[PriorityNormal].Granted; granted != 3 {
+		t.Errorf("期望 3 页放行 3 次，但得到 %d", granted)
+	}
+}
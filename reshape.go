@@ -0,0 +1,180 @@
+package tushare
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// MeltVariableColumn Melt 结果中存放原列名的列
+	MeltVariableColumn = "variable"
+	// MeltValueColumn Melt 结果中存放取值的列
+	MeltValueColumn = "value"
+)
+
+// Pivot 将长表转换为宽表（如 trade_date x ts_code 的收盘价矩阵）
+//
+// 结果的第一列为 index 列的去重值（升序），其后每个 columns 列的去重值（升序）对应一列，
+// 单元格为对应行 values 列经 aggFunc 聚合的结果，没有数据的单元格为空值。
+// aggFunc 为 nil 时使用 AggMean；index 或 columns 为空值的行会被忽略。
+func (df *DataFrame) Pivot(index, columns, values string, aggFunc AggFunc) (*DataFrame, error) {
+	series, err := df.columns([]string{index, columns, values})
+	if err != nil {
+		return nil, err
+	}
+	indexSeries, columnSeries, valueSeries := series[0], series[1], series[2]
+	if aggFunc == nil {
+		aggFunc = AggMean
+	}
+
+	rowKeys, rowOf := uniqueSorted(indexSeries)
+	colKeys, colOf := uniqueSorted(columnSeries)
+
+	// 按 (行, 列) 收集原始行号
+	cells := make([][][]int, len(colKeys))
+	for c := range cells {
+		cells[c] = make([][]int, len(rowKeys))
+	}
+	for i := 0; i < df.nrows; i++ {
+		r, c := rowOf[i], colOf[i]
+		if r < 0 || c < 0 {
+			continue
+		}
+		cells[c][r] = append(cells[c][r], i)
+	}
+
+	result := make([]*Series, 0, len(colKeys)+1)
+	result = append(result, indexSeries.take(rowKeys))
+	for c, first := range colKeys {
+		name := columnSeries.String(first)
+		if name == index {
+			return nil, fmt.Errorf("pivot column %q conflicts with index column", name)
+		}
+		cellValues := make([]interface{}, len(rowKeys))
+		for r, rows := range cells[c] {
+			if len(rows) > 0 {
+				cellValues[r] = aggFunc(valueSeries, rows)
+			}
+		}
+		result = append(result, seriesFromValues(name, cellValues))
+	}
+
+	return NewDataFrameFromSeries(result...)
+}
+
+// uniqueSorted 返回列中非空去重值的代表行号（升序），以及每行对应的去重值序号（空值为 -1）
+func uniqueSorted(s *Series) ([]int, []int) {
+	positions := make(map[string]int)
+	firsts := make([]int, 0)
+	of := make([]int, s.Len())
+	var buf []byte
+	for i := range of {
+		if s.nulls[i] {
+			of[i] = -1
+			continue
+		}
+		buf = s.appendKey(buf[:0], i, false)
+		pos, ok := positions[string(buf)]
+		if !ok {
+			pos = len(firsts)
+			positions[string(buf)] = pos
+			firsts = append(firsts, i)
+		}
+		of[i] = pos
+	}
+
+	order := rowRange(0, len(firsts))
+	sort.SliceStable(order, func(a, b int) bool {
+		return s.compare(firsts[order[a]], firsts[order[b]]) < 0
+	})
+
+	rank := make([]int, len(firsts))
+	sorted := make([]int, len(firsts))
+	for k, pos := range order {
+		rank[pos] = k
+		sorted[k] = firsts[pos]
+	}
+	for i, pos := range of {
+		if pos >= 0 {
+			of[i] = rank[pos]
+		}
+	}
+	return sorted, of
+}
+
+// Melt 将宽表转换为长表，是 Pivot 的逆操作
+//
+// 结果列依次为 idVars、variable（原列名）和 value（取值）；valueVars 为空时使用除 idVars 外的全部列。
+// 行按 valueVars 的顺序依次展开。value 列的类型：各列类型相同时沿用，均为数值时为 float64，否则为字符串。
+func (df *DataFrame) Melt(idVars, valueVars []string) (*DataFrame, error) {
+	ids, err := df.columns(idVars)
+	if err != nil {
+		return nil, err
+	}
+
+	isID := make(map[string]bool, len(idVars))
+	for _, col := range idVars {
+		isID[col] = true
+		if col == MeltVariableColumn || col == MeltValueColumn {
+			return nil, fmt.Errorf("id column %q conflicts with melt output column", col)
+		}
+	}
+	if len(valueVars) == 0 {
+		for _, col := range df.Columns {
+			if !isID[col] {
+				valueVars = append(valueVars, col)
+			}
+		}
+	}
+	vals, err := df.columns(valueVars)
+	if err != nil {
+		return nil, err
+	}
+
+	n := df.nrows * len(vals)
+	idx := make([]int, 0, n)
+	for range vals {
+		idx = append(idx, rowRange(0, df.nrows)...)
+	}
+
+	result := make([]*Series, 0, len(ids)+2)
+	for _, s := range ids {
+		result = append(result, s.take(idx))
+	}
+
+	variable := newSeries(MeltVariableColumn, ColumnString, n)
+	value := newSeries(MeltValueColumn, commonType(vals), n)
+	for _, s := range vals {
+		for i := 0; i < df.nrows; i++ {
+			variable.appendValue(s.name)
+			value.appendFrom(s, i)
+		}
+	}
+	result = append(result, variable, value)
+
+	return NewDataFrameFromSeries(result...)
+}
+
+// commonType 返回可以容纳多列数据的公共类型
+func commonType(series []*Series) ColumnType {
+	if len(series) == 0 {
+		return ColumnFloat64
+	}
+	typ := series[0].typ
+	for _, s := range series[1:] {
+		typ = unifyTypes(typ, s.typ)
+	}
+	return typ
+}
+
+// unifyTypes 合并两种列类型：相同则不变，均为数值时为 float64，否则为字符串
+func unifyTypes(a, b ColumnType) ColumnType {
+	switch {
+	case a == b:
+		return a
+	case a.isNumeric() && b.isNumeric():
+		return ColumnFloat64
+	default:
+		return ColumnString
+	}
+}
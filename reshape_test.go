@@ -0,0 +1,87 @@
+package tushare
+
+import (
+	"testing"
+)
+
+func newLongFrame() *DataFrame {
+	return NewDataFrame(&Response{
+		Data: &ResponseData{
+			Fields: []string{"ts_code", "trade_date", "close", "vol"},
+			Items: [][]interface{}{
+				{"600000.SH", "20240103", 6.6, 100.0},
+				{"000001.SZ", "20240103", 9.19, 200.0},
+				{"000001.SZ", "20240102", 9.39, 300.0},
+				{"600000.SH", "20240104", 6.7, 400.0},
+				{nil, "20240102", 1.0, 500.0},
+			},
+		},
+	})
+}
+
+func TestDataFrame_Pivot(t *testing.T) {
+	df := newLongFrame()
+
+	wide, err := df.Pivot("trade_date", "ts_code", "close", nil)
+	if err != nil {
+		t.Fatalf("Pivot 失败: %v", err)
+	}
+
+	// 列按取值升序排列，行按 index 升序排列
+	assertStrings(t, wide.Columns, []string{"trade_date", "000001.SZ", "600000.SH"})
+	assertStrings(t, columnStrings(wide, "trade_date"), []string{"20240102", "20240103", "20240104"})
+	assertStrings(t, columnStrings(wide, "000001.SZ"), []string{"9.39", "9.19", ""})
+	assertStrings(t, columnStrings(wide, "600000.SH"), []string{"", "6.6", "6.7"})
+
+	if wide.Column("trade_date").Type() != ColumnDate {
+		t.Errorf("期望 index 列保留日期类型，但得到 %s", wide.Column("trade_date").Type())
+	}
+	if !wide.Column("600000.SH").IsNull(0) {
+		t.Error("缺失的单元格应为空值")
+	}
+
+	counts, err := df.Pivot("ts_code", "trade_date", "vol", AggCount)
+	if err != nil {
+		t.Fatalf("Pivot 失败: %v", err)
+	}
+	assertStrings(t, counts.Columns, []string{"ts_code", "20240102", "20240103", "20240104"})
+	assertStrings(t, columnStrings(counts, "20240102"), []string{"1", ""})
+}
+
+func TestDataFrame_Melt(t *testing.T) {
+	df := newLongFrame()
+	wide, err := df.Pivot("trade_date", "ts_code", "close", nil)
+	if err != nil {
+		t.Fatalf("Pivot 失败: %v", err)
+	}
+
+	long, err := wide.Melt([]string{"trade_date"}, nil)
+	if err != nil {
+		t.Fatalf("Melt 失败: %v", err)
+	}
+	assertStrings(t, long.Columns, []string{"trade_date", MeltVariableColumn, MeltValueColumn})
+	if long.Len() != 6 {
+		t.Fatalf("期望 6 行，但得到 %d", long.Len())
+	}
+	assertStrings(t, columnStrings(long, MeltVariableColumn), []string{"000001.SZ", "000001.SZ", "000001.SZ", "600000.SH", "600000.SH", "600000.SH"})
+	assertStrings(t, columnStrings(long, MeltValueColumn), []string{"9.39", "9.19", "", "", "6.6", "6.7"})
+	if long.Column(MeltValueColumn).Type() != ColumnFloat64 {
+		t.Errorf("期望 value 列为 float64，但得到 %s", long.Column(MeltValueColumn).Type())
+	}
+
+	// 类型不同的列展开为字符串
+	mixed, err := df.Melt([]string{"trade_date"}, []string{"ts_code", "close"})
+	if err != nil {
+		t.Fatalf("Melt 失败: %v", err)
+	}
+	if mixed.Column(MeltValueColumn).Type() != ColumnString {
+		t.Errorf("期望 value 列为 string，但得到 %s", mixed.Column(MeltValueColumn).Type())
+	}
+	if mixed.GetString(5, MeltValueColumn) != "6.6" {
+		t.Errorf("期望第 6 行取值为 6.6，但得到 %s", mixed.GetString(5, MeltValueColumn))
+	}
+
+	if _, err := df.Melt([]string{"missing"}, nil); err == nil {
+		t.Error("id 列不存在时应返回错误")
+	}
+}
@@ -0,0 +1,293 @@
+package tushare
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultMaxStarvation 低优先级请求最多被连续插队的次数
+const DefaultMaxStarvation = 5
+
+// Priority 请求优先级
+type Priority int
+
+const (
+	// PriorityLow 低优先级（如历史数据回补等后台任务）
+	PriorityLow Priority = iota
+	// PriorityNormal 普通优先级（默认）
+	PriorityNormal
+	// PriorityHigh 高优先级（如交互式查询）
+	PriorityHigh
+
+	numPriorities = 3
+)
+
+// String 返回优先级名称
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// valid 将越界的优先级归一到合法范围
+func (p Priority) valid() Priority {
+	if p < PriorityLow {
+		return PriorityLow
+	}
+	if p > PriorityHigh {
+		return PriorityHigh
+	}
+	return p
+}
+
+// PriorityStats 单个优先级的调度统计
+type PriorityStats struct {
+	Queued    int           // 当前排队数
+	Granted   uint64        // 累计放行次数
+	TotalWait time.Duration // 累计排队等待时长
+	MaxWait   time.Duration // 最长排队等待时长
+}
+
+// AvgWait 平均排队等待时长
+func (s PriorityStats) AvgWait() time.Duration {
+	if s.Granted == 0 {
+		return 0
+	}
+	return s.TotalWait / time.Duration(s.Granted)
+}
+
+// SchedulerStats 调度器运行统计
+type SchedulerStats struct {
+	Queued     int                        // 当前排队总数
+	ByPriority map[Priority]PriorityStats // 按优先级统计
+}
+
+// waiter 排队中的请求
+type waiter struct {
+	priority Priority
+	enqueued time.Time
+	ready    chan struct{}
+	granted  bool
+}
+
+// scheduler 按优先级分配限频配额的调度器
+//
+// 所有请求共享同一个限频配额，每隔 interval 放行一个请求。
+// 有请求排队时，优先放行高优先级请求；为避免后台任务饿死，
+// 较低优先级被连续插队 maxStarve 次后，下一个配额会优先分配给它。
+type scheduler struct {
+	mu        sync.Mutex
+	interval  time.Duration
+	maxStarve int
+	next      time.Time
+	queues    [numPriorities][]*waiter
+	starved   [numPriorities]int
+	stats     [numPriorities]PriorityStats
+	timerSet  bool
+}
+
+// newScheduler 创建调度器，interval 为两次放行之间的最小间隔
+func newScheduler(interval time.Duration, maxStarve int) *scheduler {
+	return &scheduler{
+		interval:  interval,
+		maxStarve: maxStarve,
+	}
+}
+
+// newSchedulerPerMinute 根据每分钟请求数创建调度器，rate <= 0 时返回 nil（不限频）
+func newSchedulerPerMinute(rate int, maxStarve int) *scheduler {
+	if rate <= 0 {
+		return nil
+	}
+	if maxStarve <= 0 {
+		maxStarve = DefaultMaxStarvation
+	}
+	return newScheduler(time.Minute/time.Duration(rate), maxStarve)
+}
+
+// acquire 等待获取一个配额，ctx 取消时返回错误
+func (s *scheduler) acquire(ctx context.Context, p Priority) error {
+	p = p.valid()
+
+	s.mu.Lock()
+	now := time.Now()
+	if s.queuedLocked() == 0 && !now.Before(s.next) {
+		s.grantLocked(p, now, 0)
+		s.mu.Unlock()
+		return nil
+	}
+
+	w := &waiter{
+		priority: p,
+		enqueued: now,
+		ready:    make(chan struct{}),
+	}
+	s.queues[p] = append(s.queues[p], w)
+	s.scheduleLocked(now)
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		if w.granted {
+			// 配额与取消同时到达：将配额转给下一个排队请求，避免浪费
+			s.releaseLocked(time.Now())
+		} else {
+			s.removeLocked(w)
+		}
+		s.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// snapshot 返回当前统计快照
+func (s *scheduler) snapshot() SchedulerStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := SchedulerStats{
+		ByPriority: make(map[Priority]PriorityStats, numPriorities),
+	}
+	for p := range s.stats {
+		st := s.stats[p]
+		st.Queued = len(s.queues[p])
+		result.Queued += st.Queued
+		result.ByPriority[Priority(p)] = st
+	}
+	return result
+}
+
+// dispatch 定时器触发时放行一个排队请求
+func (s *scheduler) dispatch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.timerSet = false
+	now := time.Now()
+	if now.Before(s.next) {
+		s.scheduleLocked(now)
+		return
+	}
+
+	p, ok := s.pickLocked()
+	if !ok {
+		return
+	}
+
+	w := s.queues[p][0]
+	s.queues[p][0] = nil
+	s.queues[p] = s.queues[p][1:]
+	w.granted = true
+	close(w.ready)
+	s.grantLocked(p, now, now.Sub(w.enqueued))
+
+	if s.queuedLocked() > 0 {
+		s.scheduleLocked(now)
+	}
+}
+
+// pickLocked 选择下一个放行的优先级
+func (s *scheduler) pickLocked() (Priority, bool) {
+	chosen := -1
+	// 公平性：被连续插队达到上限的低优先级请求优先放行
+	if s.maxStarve > 0 {
+		for p := 0; p < numPriorities; p++ {
+			if len(s.queues[p]) > 0 && s.starved[p] >= s.maxStarve {
+				chosen = p
+				break
+			}
+		}
+	}
+	if chosen < 0 {
+		for p := numPriorities - 1; p >= 0; p-- {
+			if len(s.queues[p]) > 0 {
+				chosen = p
+				break
+			}
+		}
+	}
+	if chosen < 0 {
+		return 0, false
+	}
+
+	for p := 0; p < chosen; p++ {
+		if len(s.queues[p]) > 0 {
+			s.starved[p]++
+		}
+	}
+	s.starved[chosen] = 0
+	return Priority(chosen), true
+}
+
+// releaseLocked 归还一个已放行但未使用的配额：有排队请求时直接放行，否则回退下一个配额时间
+func (s *scheduler) releaseLocked(now time.Time) {
+	p, ok := s.pickLocked()
+	if !ok {
+		s.next = s.next.Add(-s.interval)
+		return
+	}
+	w := s.queues[p][0]
+	s.queues[p][0] = nil
+	s.queues[p] = s.queues[p][1:]
+	w.granted = true
+	close(w.ready)
+	s.recordLocked(p, now.Sub(w.enqueued))
+}
+
+// grantLocked 记录一次放行并推进下一个配额时间
+func (s *scheduler) grantLocked(p Priority, now time.Time, wait time.Duration) {
+	if s.next.Before(now) {
+		s.next = now
+	}
+	s.next = s.next.Add(s.interval)
+	s.recordLocked(p, wait)
+}
+
+// recordLocked 记录放行统计
+func (s *scheduler) recordLocked(p Priority, wait time.Duration) {
+	st := &s.stats[p]
+	st.Granted++
+	st.TotalWait += wait
+	if wait > st.MaxWait {
+		st.MaxWait = wait
+	}
+}
+
+// scheduleLocked 在下一个配额时间触发 dispatch
+func (s *scheduler) scheduleLocked(now time.Time) {
+	if s.timerSet {
+		return
+	}
+	s.timerSet = true
+	time.AfterFunc(s.next.Sub(now), s.dispatch)
+}
+
+// removeLocked 从队列中移除已取消的请求
+func (s *scheduler) removeLocked(w *waiter) {
+	queue := s.queues[w.priority]
+	for i, item := range queue {
+		if item == w {
+			s.queues[w.priority] = append(queue[:i], queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// queuedLocked 当前排队总数
+func (s *scheduler) queuedLocked() int {
+	n := 0
+	for p := range s.queues {
+		n += len(s.queues[p])
+	}
+	return n
+}
@@ -0,0 +1,196 @@
+package tushare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestScheduler_PriorityOrder(t *testing.T) {
+	s := newScheduler(20*time.Millisecond, 0)
+	ctx := context.Background()
+
+	// 占用第一个配额，后续请求都需要排队
+	if err := s.acquire(ctx, PriorityLow); err != nil {
+		t.Fatalf("获取配额失败: %v", err)
+	}
+
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+	enqueue := func(p Priority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.acquire(ctx, p); err != nil {
+				t.Errorf("获取配额失败: %v", err)
+				return
+			}
+			mu.Lock()
+			order = append(order, p)
+			mu.Unlock()
+		}()
+		// 等待请求进入队列，保证入队顺序
+		for s.snapshot().ByPriority[p].Queued == 0 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	enqueue(PriorityLow)
+	enqueue(PriorityNormal)
+	enqueue(PriorityHigh)
+	wg.Wait()
+
+	want := []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("期望放行顺序 %v，但得到 %v", want, order)
+		}
+	}
+}
+
+func TestScheduler_Fairness(t *testing.T) {
+	s := newScheduler(30*time.Millisecond, 2)
+	ctx := context.Background()
+
+	if err := s.acquire(ctx, PriorityHigh); err != nil {
+		t.Fatalf("获取配额失败: %v", err)
+	}
+
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+	start := func(p Priority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.acquire(ctx, p); err != nil {
+				t.Errorf("获取配额失败: %v", err)
+				return
+			}
+			mu.Lock()
+			order = append(order, p)
+			mu.Unlock()
+		}()
+	}
+
+	start(PriorityLow)
+	for i := 0; i < 6; i++ {
+		start(PriorityHigh)
+	}
+	for s.snapshot().Queued < 7 {
+		time.Sleep(time.Millisecond)
+	}
+	wg.Wait()
+
+	// 低优先级最多被连续插队 2 次
+	for i, p := range order {
+		if p == PriorityLow {
+			if i > 2 {
+				t.Errorf("低优先级请求被插队 %d 次，超过上限 2 次，顺序: %v", i, order)
+			}
+			return
+		}
+	}
+	t.Errorf("低优先级请求未被放行，顺序: %v", order)
+}
+
+func TestScheduler_ContextCancel(t *testing.T) {
+	s := newScheduler(time.Hour, 0)
+
+	if err := s.acquire(context.Background(), PriorityNormal); err != nil {
+		t.Fatalf("获取配额失败: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := s.acquire(ctx, PriorityNormal); err != context.DeadlineExceeded {
+		t.Errorf("期望返回超时错误，但得到 %v", err)
+	}
+
+	if queued := s.snapshot().Queued; queued != 0 {
+		t.Errorf("取消后期望队列为空，但仍有 %d 个请求", queued)
+	}
+}
+
+func TestClient_RateLimitStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := Response{
+			Code: 0,
+			Data: &ResponseData{
+				Fields:  []string{"ts_code"},
+				Items:   [][]interface{}{{"000001.SZ"}},
+				HasMore: false,
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	// 每分钟 3000 次，即每 20ms 放行一次
+	client := NewClient("test_token",
+		WithHTTPURL(server.URL),
+		WithRateLimit(3000),
+	)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.Query("stock_basic", nil, "", WithPriority(PriorityHigh)); err != nil {
+			t.Fatalf("查询失败: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("期望限频生效（至少 40ms），但只用了 %v", elapsed)
+	}
+
+	stats := client.SchedulerStats()
+	if stats.ByPriority[PriorityHigh].Granted != 3 {
+		t.Errorf("期望高优先级放行 3 次，但得到 %d", stats.ByPriority[PriorityHigh].Granted)
+	}
+	if stats.Queued != 0 {
+		t.Errorf("期望队列为空，但得到 %d", stats.Queued)
+	}
+}
+
+func TestScheduler_ReleaseGrantedSlot(t *testing.T) {
+	// 配额间隔很长，只有归还的配额能让后续请求及时放行
+	s := newScheduler(time.Hour, 0)
+	ctx := context.Background()
+	if err := s.acquire(ctx, PriorityNormal); err != nil {
+		t.Fatalf("获取配额失败: %v", err)
+	}
+
+	// 已放行的请求被取消时，配额直接转给排队中的请求
+	done := make(chan error, 1)
+	go func() { done <- s.acquire(ctx, PriorityLow) }()
+	for s.snapshot().Queued == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	s.mu.Lock()
+	s.releaseLocked(time.Now())
+	s.mu.Unlock()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("获取配额失败: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("期望归还的配额放行排队请求")
+	}
+
+	// 没有排队请求时回退下一个配额时间
+	s.mu.Lock()
+	s.releaseLocked(time.Now())
+	s.mu.Unlock()
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if err := s.acquire(ctx, PriorityNormal); err != nil {
+		t.Fatalf("期望归还的配额可立即使用，但得到 %v", err)
+	}
+}
@@ -0,0 +1,587 @@
+package tushare
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateLayout Tushare 日期格式（YYYYMMDD）
+const DateLayout = "20060102"
+
+// ColumnType 列数据类型
+type ColumnType int
+
+const (
+	// ColumnString 字符串列
+	ColumnString ColumnType = iota
+	// ColumnFloat64 浮点数列
+	ColumnFloat64
+	// ColumnInt64 整数列
+	ColumnInt64
+	// ColumnDate 日期列（Tushare 的 YYYYMMDD 日期）
+	ColumnDate
+)
+
+// String 返回列类型名称
+func (t ColumnType) String() string {
+	switch t {
+	case ColumnString:
+		return "string"
+	case ColumnFloat64:
+		return "float64"
+	case ColumnInt64:
+		return "int64"
+	case ColumnDate:
+		return "date"
+	default:
+		return "unknown"
+	}
+}
+
+// isNumeric 是否数值类型
+func (t ColumnType) isNumeric() bool {
+	return t == ColumnFloat64 || t == ColumnInt64
+}
+
+// Series 带空值掩码的类型化列
+//
+// 每列只使用与其类型对应的切片存储数据，空值由 nulls 掩码标记，
+// 空值位置上的数据为对应类型的零值。
+type Series struct {
+	name   string
+	typ    ColumnType
+	floats []float64
+	ints   []int64
+	strs   []string
+	dates  []time.Time
+	nulls  []bool
+}
+
+// NewFloat64Series 创建浮点数列，nulls 为 nil 表示没有空值
+func NewFloat64Series(name string, values []float64, nulls []bool) *Series {
+	return &Series{name: name, typ: ColumnFloat64, floats: values, nulls: normalizeNulls(nulls, len(values))}
+}
+
+// NewInt64Series 创建整数列，nulls 为 nil 表示没有空值
+func NewInt64Series(name string, values []int64, nulls []bool) *Series {
+	return &Series{name: name, typ: ColumnInt64, ints: values, nulls: normalizeNulls(nulls, len(values))}
+}
+
+// NewStringSeries 创建字符串列，nulls 为 nil 表示没有空值
+func NewStringSeries(name string, values []string, nulls []bool) *Series {
+	return &Series{name: name, typ: ColumnString, strs: values, nulls: normalizeNulls(nulls, len(values))}
+}
+
+// NewDateSeries 创建日期列，nulls 为 nil 表示没有空值
+func NewDateSeries(name string, values []time.Time, nulls []bool) *Series {
+	return &Series{name: name, typ: ColumnDate, dates: values, nulls: normalizeNulls(nulls, len(values))}
+}
+
+// normalizeNulls 将空值掩码补齐到 n 个元素
+func normalizeNulls(nulls []bool, n int) []bool {
+	if len(nulls) == n {
+		return nulls
+	}
+	result := make([]bool, n)
+	copy(result, nulls)
+	return result
+}
+
+// newSeries 创建指定类型的空列
+func newSeries(name string, typ ColumnType, capacity int) *Series {
+	s := &Series{
+		name:  name,
+		typ:   typ,
+		nulls: make([]bool, 0, capacity),
+	}
+	switch typ {
+	case ColumnFloat64:
+		s.floats = make([]float64, 0, capacity)
+	case ColumnInt64:
+		s.ints = make([]int64, 0, capacity)
+	case ColumnDate:
+		s.dates = make([]time.Time, 0, capacity)
+	default:
+		s.strs = make([]string, 0, capacity)
+	}
+	return s
+}
+
+// Name 列名
+func (s *Series) Name() string {
+	return s.name
+}
+
+// Type 列类型
+func (s *Series) Type() ColumnType {
+	return s.typ
+}
+
+// Len 元素个数
+func (s *Series) Len() int {
+	return len(s.nulls)
+}
+
+// IsNull 判断第 i 个元素是否为空
+func (s *Series) IsNull(i int) bool {
+	return s.nulls[i]
+}
+
+// NullCount 空值个数
+func (s *Series) NullCount() int {
+	n := 0
+	for _, null := range s.nulls {
+		if null {
+			n++
+		}
+	}
+	return n
+}
+
+// Nulls 返回空值掩码（与列共享存储，不应修改）
+func (s *Series) Nulls() []bool {
+	return s.nulls
+}
+
+// Float64s 返回浮点数切片，非浮点数列返回 nil（与列共享存储，不应修改）
+func (s *Series) Float64s() []float64 {
+	return s.floats
+}
+
+// Int64s 返回整数切片，非整数列返回 nil（与列共享存储，不应修改）
+func (s *Series) Int64s() []int64 {
+	return s.ints
+}
+
+// Strings 返回字符串切片，非字符串列返回 nil（与列共享存储，不应修改）
+func (s *Series) Strings() []string {
+	return s.strs
+}
+
+// Dates 返回日期切片，非日期列返回 nil（与列共享存储，不应修改）
+func (s *Series) Dates() []time.Time {
+	return s.dates
+}
+
+// Value 返回第 i 个元素，空值返回 nil
+// 返回值类型为 float64、int64、string 或 time.Time
+func (s *Series) Value(i int) interface{} {
+	if s.nulls[i] {
+		return nil
+	}
+	switch s.typ {
+	case ColumnFloat64:
+		return s.floats[i]
+	case ColumnInt64:
+		return s.ints[i]
+	case ColumnDate:
+		return s.dates[i]
+	default:
+		return s.strs[i]
+	}
+}
+
+// Float64 以浮点数返回第 i 个元素，空值或无法转换时 ok 为 false
+func (s *Series) Float64(i int) (float64, bool) {
+	if s.nulls[i] {
+		return 0, false
+	}
+	switch s.typ {
+	case ColumnFloat64:
+		return s.floats[i], true
+	case ColumnInt64:
+		return float64(s.ints[i]), true
+	case ColumnString:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s.strs[i]), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// String 以字符串返回第 i 个元素，空值返回空字符串
+func (s *Series) String(i int) string {
+	if s.nulls[i] {
+		return ""
+	}
+	switch s.typ {
+	case ColumnFloat64:
+		return strconv.FormatFloat(s.floats[i], 'g', -1, 64)
+	case ColumnInt64:
+		return strconv.FormatInt(s.ints[i], 10)
+	case ColumnDate:
+		return s.dates[i].Format(DateLayout)
+	default:
+		return s.strs[i]
+	}
+}
+
+// appendNull 追加空值
+func (s *Series) appendNull() {
+	s.nulls = append(s.nulls, true)
+	switch s.typ {
+	case ColumnFloat64:
+		s.floats = append(s.floats, 0)
+	case ColumnInt64:
+		s.ints = append(s.ints, 0)
+	case ColumnDate:
+		s.dates = append(s.dates, time.Time{})
+	default:
+		s.strs = append(s.strs, "")
+	}
+}
+
+// appendValue 按列类型转换并追加元素，nil 或无法转换的值记为空值
+func (s *Series) appendValue(v interface{}) {
+	if v == nil {
+		s.appendNull()
+		return
+	}
+
+	switch s.typ {
+	case ColumnFloat64:
+		f, ok := toFloat64(v)
+		if !ok {
+			s.appendNull()
+			return
+		}
+		s.floats = append(s.floats, f)
+	case ColumnInt64:
+		n, ok := toInt64(v)
+		if !ok {
+			s.appendNull()
+			return
+		}
+		s.ints = append(s.ints, n)
+	case ColumnDate:
+		d, ok := toDate(v)
+		if !ok {
+			s.appendNull()
+			return
+		}
+		s.dates = append(s.dates, d)
+	default:
+		s.strs = append(s.strs, toString(v))
+	}
+	s.nulls = append(s.nulls, false)
+}
+
+// appendFrom 追加另一列的第 i 个元素
+func (s *Series) appendFrom(src *Series, i int) {
+	if src.nulls[i] {
+		s.appendNull()
+		return
+	}
+	if src.typ != s.typ {
+		s.appendValue(src.Value(i))
+		return
+	}
+	switch s.typ {
+	case ColumnFloat64:
+		s.floats = append(s.floats, src.floats[i])
+	case ColumnInt64:
+		s.ints = append(s.ints, src.ints[i])
+	case ColumnDate:
+		s.dates = append(s.dates, src.dates[i])
+	default:
+		s.strs = append(s.strs, src.strs[i])
+	}
+	s.nulls = append(s.nulls, false)
+}
+
+// take 按行号取出元素组成新列，行号为负表示空值
+func (s *Series) take(idx []int) *Series {
+	result := newSeries(s.name, s.typ, len(idx))
+	for _, i := range idx {
+		if i < 0 {
+			result.appendNull()
+			continue
+		}
+		result.appendFrom(s, i)
+	}
+	return result
+}
+
+// Rename 返回共享数据、名称不同的列
+func (s *Series) Rename(name string) *Series {
+	c := *s
+	c.name = name
+	return &c
+}
+
+// key 返回第 i 个元素的哈希键，用于分组和连接
+func (s *Series) key(i int) string {
+	if s.nulls[i] {
+		return "\x00"
+	}
+	return s.String(i)
+}
+
+// appendKey 将第 i 个元素编码追加到 buf，用于分组和连接的哈希键
+// 数值统一按 float64 编码，使整数列与浮点数列可以互相匹配；
+// textual 为 true 时按字符串形式编码，用于两侧列类型不兼容时的连接
+func (s *Series) appendKey(buf []byte, i int, textual bool) []byte {
+	if s.nulls[i] {
+		return append(buf, 0)
+	}
+
+	var bits uint64
+	switch {
+	case textual || s.typ == ColumnString:
+		str := s.String(i)
+		buf = append(buf, 3)
+		buf = binary.AppendUvarint(buf, uint64(len(str)))
+		return append(buf, str...)
+	case s.typ == ColumnDate:
+		buf = append(buf, 2)
+		bits = uint64(s.dates[i].Unix())
+	case s.typ == ColumnInt64:
+		buf = append(buf, 1)
+		bits = math.Float64bits(float64(s.ints[i]))
+	default:
+		buf = append(buf, 1)
+		f := s.floats[i]
+		if f == 0 {
+			f = 0 // 统一 -0 与 +0
+		}
+		bits = math.Float64bits(f)
+	}
+	return binary.LittleEndian.AppendUint64(buf, bits)
+}
+
+// compare 比较第 i 和第 j 个元素，空值排在最后
+func (s *Series) compare(i, j int) int {
+	ni, nj := s.nulls[i], s.nulls[j]
+	switch {
+	case ni && nj:
+		return 0
+	case ni:
+		return 1
+	case nj:
+		return -1
+	}
+
+	switch s.typ {
+	case ColumnFloat64:
+		return compareFloat64(s.floats[i], s.floats[j])
+	case ColumnInt64:
+		return compareInt64(s.ints[i], s.ints[j])
+	case ColumnDate:
+		return s.dates[i].Compare(s.dates[j])
+	default:
+		return strings.Compare(s.strs[i], s.strs[j])
+	}
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ==================== 类型转换 ====================
+
+// toFloat64 将任意值转换为 float64
+func toFloat64(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case float32:
+		return float64(x), true
+	case int:
+		return float64(x), true
+	case int8:
+		return float64(x), true
+	case int16:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case uint:
+		return float64(x), true
+	case uint8:
+		return float64(x), true
+	case uint16:
+		return float64(x), true
+	case uint32:
+		return float64(x), true
+	case uint64:
+		return float64(x), true
+	case json.Number:
+		f, err := x.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		return f, err == nil
+	case bool:
+		if x {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// toInt64 将任意值转换为 int64（浮点数向零取整）
+func toInt64(v interface{}) (int64, bool) {
+	switch x := v.(type) {
+	case int:
+		return int64(x), true
+	case int8:
+		return int64(x), true
+	case int16:
+		return int64(x), true
+	case int32:
+		return int64(x), true
+	case int64:
+		return x, true
+	case uint:
+		return int64(x), true
+	case uint8:
+		return int64(x), true
+	case uint16:
+		return int64(x), true
+	case uint32:
+		return int64(x), true
+	case uint64:
+		return int64(x), true
+	case string:
+		if n, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64); err == nil {
+			return n, true
+		}
+	}
+	f, ok := toFloat64(v)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+// toDate 将任意值转换为日期，支持 YYYYMMDD 和 YYYY-MM-DD 字符串及 20240102 形式的数字
+func toDate(v interface{}) (time.Time, bool) {
+	switch x := v.(type) {
+	case time.Time:
+		return x, true
+	case Date:
+		return x.Time, !x.IsZero()
+	case string:
+		return parseDate(x)
+	}
+	if n, ok := toInt64(v); ok {
+		return parseDate(strconv.FormatInt(n, 10))
+	}
+	return time.Time{}, false
+}
+
+// parseDate 解析 YYYYMMDD 或 YYYY-MM-DD 日期
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	layout := DateLayout
+	if len(s) == 10 {
+		layout = "2006-01-02"
+	}
+	t, err := time.Parse(layout, s)
+	return t, err == nil
+}
+
+// toString 将任意值转换为字符串
+func toString(v interface{}) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case int:
+		return strconv.Itoa(x)
+	case time.Time:
+		return x.Format(DateLayout)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// ==================== 类型推断 ====================
+
+// isDateColumnName 判断列名是否为 Tushare 的日期列
+func isDateColumnName(name string) bool {
+	return strings.HasSuffix(name, "_date")
+}
+
+// isDateString 判断是否为 YYYYMMDD 格式的日期字符串
+func isDateString(s string) bool {
+	if len(s) != 8 {
+		return false
+	}
+	_, err := time.Parse(DateLayout, s)
+	return err == nil
+}
+
+// inferColumnType 根据列名和取值推断列类型
+//
+//   - 全部为整数类型（Go 的 int/int64 等）时推断为 int64
+//   - 全部为数值时推断为 float64（JSON 中的数字均解码为 float64）
+//   - 列名以 _date 结尾且全部为 YYYYMMDD 字符串时推断为日期
+//   - 其余情况推断为字符串；全部为空的列推断为 float64
+func inferColumnType(name string, n int, at func(i int) interface{}) ColumnType {
+	var seen, ints, floats, dateStrs, times int
+
+	for i := 0; i < n; i++ {
+		v := at(i)
+		if v == nil {
+			continue
+		}
+		seen++
+		switch x := v.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			ints++
+		case float64, float32, json.Number:
+			floats++
+		case string:
+			if isDateString(x) {
+				dateStrs++
+			}
+		case time.Time:
+			times++
+		}
+	}
+
+	switch {
+	case seen == 0:
+		return ColumnFloat64
+	case ints == seen:
+		return ColumnInt64
+	case ints+floats == seen:
+		return ColumnFloat64
+	case times == seen:
+		return ColumnDate
+	case times+dateStrs == seen && (times > 0 || isDateColumnName(name)):
+		return ColumnDate
+	default:
+		return ColumnString
+	}
+}
@@ -0,0 +1,305 @@
+package basic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// ErrDateOutOfRange 日期或计算结果超出交易日历的覆盖范围
+var ErrDateOutOfRange = errors.New("date out of calendar range")
+
+// Calendar 单个交易所的交易日历，提供交易日判断和按交易日推移等计算
+//
+// 日期均为 YYYYMMDD 格式的字符串。Calendar 创建后不再修改，可并发使用；
+// 需要更新数据时重新加载（见 Calendars.Refresh）。
+type Calendar struct {
+	exchange TradeCalExchange
+	first    string   // 覆盖范围的第一天（含休市日）
+	last     string   // 覆盖范围的最后一天（含休市日）
+	days     []string // 升序排列的交易日
+}
+
+// NewCalendar 由 trade_cal 返回的数据创建交易日历
+//
+// items 需包含 cal_date 和 is_open 字段，顺序不限；覆盖范围为 items 中最早到最晚的日期，
+// 因此应包含休市日（查询时不要指定 IsOpen）。
+func NewCalendar(exchange TradeCalExchange, items []*TradeCalItem) (*Calendar, error) {
+	if exchange == "" {
+		exchange = TradeCalExchangeSSE
+	}
+	cal := &Calendar{exchange: exchange}
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if _, err := time.Parse(tushare.DateLayout, item.CalDate); err != nil {
+			return nil, fmt.Errorf("invalid cal_date %q", item.CalDate)
+		}
+		if cal.first == "" || item.CalDate < cal.first {
+			cal.first = item.CalDate
+		}
+		if item.CalDate > cal.last {
+			cal.last = item.CalDate
+		}
+		if item.IsOpen == TradeCalIsOpenYes && !seen[item.CalDate] {
+			seen[item.CalDate] = true
+			cal.days = append(cal.days, item.CalDate)
+		}
+	}
+	if cal.first == "" {
+		return nil, fmt.Errorf("empty trade calendar of %s", exchange)
+	}
+	sort.Strings(cal.days)
+	return cal, nil
+}
+
+// LoadCalendar 查询 trade_cal 并创建交易日历（自动处理分页）
+// exchange 为空时使用上交所，startDate/endDate 为空时由接口决定范围
+func LoadCalendar(c tushare.Querier, exchange TradeCalExchange, startDate, endDate string, opts ...tushare.QueryOption) (*Calendar, error) {
+	items, err := TradeCal(c, &TradeCalParams{
+		Exchange:  exchange,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Fields:    []string{TradeCalFieldExchange, TradeCalFieldCalDate, TradeCalFieldIsOpen},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewCalendar(exchange, items)
+}
+
+// Exchange 返回交易所代码
+func (cal *Calendar) Exchange() TradeCalExchange {
+	return cal.exchange
+}
+
+// Start 返回覆盖范围的第一天
+func (cal *Calendar) Start() string {
+	return cal.first
+}
+
+// End 返回覆盖范围的最后一天
+func (cal *Calendar) End() string {
+	return cal.last
+}
+
+// Days 返回覆盖范围内的全部交易日（升序）
+func (cal *Calendar) Days() []string {
+	return append([]string(nil), cal.days...)
+}
+
+// IsTradingDay 判断 date 是否为交易日
+func (cal *Calendar) IsTradingDay(date string) (bool, error) {
+	if err := cal.check(date); err != nil {
+		return false, err
+	}
+	k := sort.SearchStrings(cal.days, date)
+	return k < len(cal.days) && cal.days[k] == date, nil
+}
+
+// Next 返回 date 之后的第 n 个交易日（n >= 1，不含 date 本身）
+func (cal *Calendar) Next(date string, n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("n must be positive, got %d", n)
+	}
+	return cal.Offset(date, n)
+}
+
+// Prev 返回 date 之前的第 n 个交易日（n >= 1，不含 date 本身）
+func (cal *Calendar) Prev(date string, n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("n must be positive, got %d", n)
+	}
+	return cal.Offset(date, -n)
+}
+
+// Offset 将 date 推移 n 个交易日，n 为负数时向前推移
+//
+// n 为 0 时，date 为交易日则返回 date，否则返回其后的第一个交易日；
+// date 为休市日时，Offset(date, 1) 为其后的第一个交易日，Offset(date, -1) 为其前的最后一个交易日。
+// 结果超出覆盖范围时返回 ErrDateOutOfRange。
+func (cal *Calendar) Offset(date string, n int) (string, error) {
+	if err := cal.check(date); err != nil {
+		return "", err
+	}
+	k := sort.SearchStrings(cal.days, date)
+	idx := k + n
+	if n > 0 && (k >= len(cal.days) || cal.days[k] != date) {
+		// date 为休市日时，其后的第一个交易日即为第 1 个
+		idx--
+	}
+	if idx < 0 || idx >= len(cal.days) {
+		return "", fmt.Errorf("%w: %d trading days from %s", ErrDateOutOfRange, n, date)
+	}
+	return cal.days[idx], nil
+}
+
+// Range 返回 [start, end] 区间内的交易日（升序），start 晚于 end 时返回空
+func (cal *Calendar) Range(start, end string) ([]string, error) {
+	if err := cal.check(start); err != nil {
+		return nil, err
+	}
+	if err := cal.check(end); err != nil {
+		return nil, err
+	}
+	lo := sort.SearchStrings(cal.days, start)
+	hi := sort.Search(len(cal.days), func(k int) bool { return cal.days[k] > end })
+	if lo >= hi {
+		return []string{}, nil
+	}
+	return append([]string(nil), cal.days[lo:hi]...), nil
+}
+
+// TradingDaysBetween 返回 [start, end] 区间内的交易日数量
+func (cal *Calendar) TradingDaysBetween(start, end string) (int, error) {
+	days, err := cal.Range(start, end)
+	if err != nil {
+		return 0, err
+	}
+	return len(days), nil
+}
+
+// LastTradingDayOfWeek 返回 date 所在自然周（周一至周日）的最后一个交易日
+// 该周全部休市时返回错误
+func (cal *Calendar) LastTradingDayOfWeek(date string) (string, error) {
+	t, err := time.Parse(tushare.DateLayout, date)
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q", date)
+	}
+	weekday := (int(t.Weekday()) + 6) % 7 // 周一为 0
+	monday := t.AddDate(0, 0, -weekday)
+	return cal.lastIn(date, monday, monday.AddDate(0, 0, 6), "week")
+}
+
+// LastTradingDayOfMonth 返回 date 所在月份的最后一个交易日
+// 该月全部休市时返回错误
+func (cal *Calendar) LastTradingDayOfMonth(date string) (string, error) {
+	t, err := time.Parse(tushare.DateLayout, date)
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q", date)
+	}
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return cal.lastIn(date, first, first.AddDate(0, 1, -1), "month")
+}
+
+// lastIn 返回 [from, to] 区间的最后一个交易日，to 需在覆盖范围内
+func (cal *Calendar) lastIn(date string, from, to time.Time, period string) (string, error) {
+	if err := cal.check(date); err != nil {
+		return "", err
+	}
+	end := to.Format(tushare.DateLayout)
+	if end > cal.last {
+		return "", fmt.Errorf("%w: %s of %s ends after %s", ErrDateOutOfRange, period, date, cal.last)
+	}
+	k := sort.Search(len(cal.days), func(k int) bool { return cal.days[k] > end })
+	if k == 0 || cal.days[k-1] < from.Format(tushare.DateLayout) {
+		return "", fmt.Errorf("no trading day in %s of %s", period, date)
+	}
+	return cal.days[k-1], nil
+}
+
+// check 校验日期格式并确认在覆盖范围内
+func (cal *Calendar) check(date string) error {
+	if _, err := time.Parse(tushare.DateLayout, date); err != nil {
+		return fmt.Errorf("invalid date %q", date)
+	}
+	if date < cal.first || date > cal.last {
+		return fmt.Errorf("%w: %s not in [%s, %s] of %s", ErrDateOutOfRange, date, cal.first, cal.last, cal.exchange)
+	}
+	return nil
+}
+
+// Calendars 按交易所缓存的交易日历，首次使用某交易所时加载，可并发使用
+//
+// 加载按交易所分别加锁：查询接口期间不会阻塞其他交易所，也不会阻塞已缓存日历的读取。
+type Calendars struct {
+	client    tushare.Querier
+	startDate string
+	endDate   string
+	opts      []tushare.QueryOption
+
+	mu        sync.Mutex // 保护 calendars 及其中的 cal 字段
+	calendars map[TradeCalExchange]*calendarEntry
+}
+
+// calendarEntry 单个交易所的缓存项
+type calendarEntry struct {
+	loading sync.Mutex // 加载期间持有，同一交易所同时只有一次查询
+	cal     *Calendar  // 已加载的日历，未加载时为 nil
+}
+
+// NewCalendars 创建交易日历缓存，各交易所均加载 [startDate, endDate] 范围的日历
+func NewCalendars(c tushare.Querier, startDate, endDate string, opts ...tushare.QueryOption) *Calendars {
+	return &Calendars{
+		client:    c,
+		startDate: startDate,
+		endDate:   endDate,
+		opts:      opts,
+		calendars: make(map[TradeCalExchange]*calendarEntry),
+	}
+}
+
+// Get 返回交易所的交易日历，未加载时查询接口，exchange 为空时使用上交所
+// 多个协程同时获取未加载的交易所时只查询一次
+func (s *Calendars) Get(exchange TradeCalExchange) (*Calendar, error) {
+	if exchange == "" {
+		exchange = TradeCalExchangeSSE
+	}
+	entry, cal := s.entry(exchange)
+	if cal != nil {
+		return cal, nil
+	}
+
+	entry.loading.Lock()
+	defer entry.loading.Unlock()
+	// 等待期间其他协程可能已加载完成
+	s.mu.Lock()
+	cal = entry.cal
+	s.mu.Unlock()
+	if cal != nil {
+		return cal, nil
+	}
+	return s.load(exchange, entry)
+}
+
+// Refresh 重新加载交易所的交易日历；加载失败时保留原有日历
+func (s *Calendars) Refresh(exchange TradeCalExchange) (*Calendar, error) {
+	if exchange == "" {
+		exchange = TradeCalExchangeSSE
+	}
+	entry, _ := s.entry(exchange)
+	entry.loading.Lock()
+	defer entry.loading.Unlock()
+	return s.load(exchange, entry)
+}
+
+// entry 返回交易所的缓存项（不存在时创建）及已加载的日历
+func (s *Calendars) entry(exchange TradeCalExchange) (*calendarEntry, *Calendar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.calendars[exchange]
+	if !ok {
+		entry = &calendarEntry{}
+		s.calendars[exchange] = entry
+	}
+	return entry, entry.cal
+}
+
+// load 查询并缓存交易日历，调用方需持有 entry.loading（不能持有 s.mu）
+func (s *Calendars) load(exchange TradeCalExchange, entry *calendarEntry) (*Calendar, error) {
+	cal, err := LoadCalendar(s.client, exchange, s.startDate, s.endDate, s.opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	entry.cal = cal
+	s.mu.Unlock()
+	return cal, nil
+}
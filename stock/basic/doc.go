@@ -4,6 +4,9 @@
 //   - stock_basic: 股票基础信息
 //   - trade_cal: 交易日历
 //
+// 以及交易日历工具 Calendar（LoadCalendar/NewCalendar 创建，Calendars 按交易所缓存），
+// 提供交易日判断、按交易日推移、区间交易日和周、月最后一个交易日等计算。
+//
 // 文档参考:
 //   - stock_basic: https://tushare.pro/document/2?doc_id=25
 //   - trade_cal: https://tushare.pro/document/2?doc_id=26
@@ -3,6 +3,7 @@ package basic_test
 import (
 	"fmt"
 	"log"
+	"time"
 
 	tushare "github.com/fletcherlau/go-tushare"
 	"github.com/fletcherlau/go-tushare/stock/basic"
@@ -60,3 +61,53 @@ func ExampleTradeCal() {
 			items[0].Exchange, items[0].CalDate, items[0].IsOpen)
 	}
 }
+
+func ExampleNewCalendar() {
+	// 构造 2024 年 2 月的上交所日历：周末及春节（2 月 9 日至 16 日）休市
+	var items []*basic.TradeCalItem
+	for d := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC); d.Month() == time.February; d = d.AddDate(0, 0, 1) {
+		isOpen := basic.TradeCalIsOpenYes
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday || (d.Day() >= 9 && d.Day() <= 16) {
+			isOpen = basic.TradeCalIsOpenNo
+		}
+		items = append(items, &basic.TradeCalItem{
+			Exchange: basic.TradeCalExchangeSSE,
+			CalDate:  d.Format(tushare.DateLayout),
+			IsOpen:   isOpen,
+		})
+	}
+
+	cal, err := basic.NewCalendar(basic.TradeCalExchangeSSE, items)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	open, _ := cal.IsTradingDay("20240212")
+	next, _ := cal.Next("20240208", 1)
+	prev, _ := cal.Prev("20240219", 2)
+	offset, _ := cal.Offset("20240210", 0)
+	count, _ := cal.TradingDaysBetween("20240205", "20240223")
+	week, _ := cal.LastTradingDayOfWeek("20240207")
+	month, _ := cal.LastTradingDayOfMonth("20240201")
+	fmt.Println(open, next, prev, offset, count, week, month)
+	// Output: false 20240219 20240207 20240219 9 20240208 20240229
+}
+
+func ExampleCalendars() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 按交易所缓存 2024 年的交易日历，首次使用时加载
+	calendars := basic.NewCalendars(client, "20240101", "20241231")
+
+	cal, err := calendars.Get(basic.TradeCalExchangeSHFE)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	next, err := cal.Next("20240930", 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s 国庆节后第一个交易日: %s\n", cal.Exchange(), next)
+}
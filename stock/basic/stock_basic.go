@@ -113,7 +113,7 @@ type StockBasicItem struct {
 
 // StockBasic 获取股票基础信息（自动处理分页）
 // 根据指定条件获取股票基础信息数据
-func StockBasic(c *tushare.Client, params *StockBasicParams, opts ...tushare.QueryOption) ([]*StockBasicItem, error) {
+func StockBasic(c tushare.Querier, params *StockBasicParams, opts ...tushare.QueryOption) ([]*StockBasicItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
@@ -68,7 +68,7 @@ type TradeCalItem struct {
 
 // TradeCal 获取交易日历数据（自动处理分页）
 // 根据指定条件获取各大交易所的交易日历信息
-func TradeCal(c *tushare.Client, params *TradeCalParams, opts ...tushare.QueryOption) ([]*TradeCalItem, error) {
+func TradeCal(c tushare.Querier, params *TradeCalParams, opts ...tushare.QueryOption) ([]*TradeCalItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.Exchange != "" {
 		reqParams["exchange"] = string(params.Exchange)
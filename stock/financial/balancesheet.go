@@ -58,7 +58,7 @@ type BalanceSheetItem struct {
 }
 
 // BalanceSheet 获取资产负债表数据（自动处理分页）
-func BalanceSheet(c *tushare.Client, params *BalanceSheetParams, opts ...tushare.QueryOption) ([]*BalanceSheetItem, error) {
+func BalanceSheet(c tushare.Querier, params *BalanceSheetParams, opts ...tushare.QueryOption) ([]*BalanceSheetItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
@@ -66,7 +66,7 @@ type CashFlowItem struct {
 }
 
 // CashFlow 获取现金流量表数据（自动处理分页）
-func CashFlow(c *tushare.Client, params *CashFlowParams, opts ...tushare.QueryOption) ([]*CashFlowItem, error) {
+func CashFlow(c tushare.Querier, params *CashFlowParams, opts ...tushare.QueryOption) ([]*CashFlowItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
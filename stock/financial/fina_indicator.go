@@ -75,7 +75,7 @@ type FinaIndicatorItem struct {
 
 // FinaIndicator 获取财务指标数据（自动处理分页）
 // 注意: 该接口每次请求最多返回100条记录
-func FinaIndicator(c *tushare.Client, params *FinaIndicatorParams, opts ...tushare.QueryOption) ([]*FinaIndicatorItem, error) {
+func FinaIndicator(c tushare.Querier, params *FinaIndicatorParams, opts ...tushare.QueryOption) ([]*FinaIndicatorItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
@@ -75,7 +75,7 @@ type IncomeItem struct {
 }
 
 // Income 获取利润表数据（自动处理分页）
-func Income(c *tushare.Client, params *IncomeParams, opts ...tushare.QueryOption) ([]*IncomeItem, error) {
+func Income(c tushare.Querier, params *IncomeParams, opts ...tushare.QueryOption) ([]*IncomeItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
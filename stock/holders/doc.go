@@ -0,0 +1,42 @@
+// Package holders 提供 Tushare 股东及股权数据接口
+//
+// 本包目前包含以下接口：
+//   - top10_holders: 前十大股东
+//   - top10_floatholders: 前十大流通股东
+//   - stk_holdernumber: 股东人数
+//   - stk_holdertrade: 股东增减持
+//   - pledge_stat: 股权质押统计
+//
+// 以及以下工具：
+//   - PivotTop10Holders/PivotTop10Floatholders: 按股东对比各报告期的前十大股东，标出进入、退出、增持和减持
+//
+// 文档参考:
+//   - top10_holders: https://tushare.pro/document/2?doc_id=61
+//   - top10_floatholders: https://tushare.pro/document/2?doc_id=62
+//   - stk_holdernumber: https://tushare.pro/document/2?doc_id=166
+//   - stk_holdertrade: https://tushare.pro/document/2?doc_id=175
+//   - pledge_stat: https://tushare.pro/document/2?doc_id=110
+//
+// 使用示例：
+//
+//	import (
+//	    tushare "github.com/fletcherlau/go-tushare"
+//	    "github.com/fletcherlau/go-tushare/stock/holders"
+//	)
+//
+//	func main() {
+//	    client := tushare.NewClient("your_token")
+//
+//	    // 获取前十大股东
+//	    items, err := holders.Top10Holders(client, &holders.Top10HoldersParams{
+//	        TSCode:    "600000.SH",
+//	        StartDate: "20230101",
+//	        EndDate:   "20231231",
+//	    })
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//
+//	    fmt.Printf("获取 %d 条记录\n", len(items))
+//	}
+package holders
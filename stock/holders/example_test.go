@@ -0,0 +1,136 @@
+package holders_test
+
+import (
+	"fmt"
+	"log"
+
+	tushare "github.com/fletcherlau/go-tushare"
+	"github.com/fletcherlau/go-tushare/stock/holders"
+)
+
+func ExampleTop10Holders() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取浦发银行2023年各报告期的前十大股东
+	items, err := holders.Top10Holders(client, &holders.Top10HoldersParams{
+		TSCode:    "600000.SH",
+		StartDate: "20230101",
+		EndDate:   "20231231",
+		Fields: []string{
+			holders.Top10HoldersFieldTSCode,
+			holders.Top10HoldersFieldAnnDate,
+			holders.Top10HoldersFieldEndDate,
+			holders.Top10HoldersFieldHolderName,
+			holders.Top10HoldersFieldHoldAmount,
+			holders.Top10HoldersFieldHoldRatio,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 按股东列出各报告期的变化
+	for _, pivot := range holders.PivotTop10Holders(items) {
+		for _, holder := range pivot.Holders {
+			last := holder.Cells[len(holder.Cells)-1]
+			fmt.Printf("%s %s %s\n", pivot.Periods[len(pivot.Periods)-1], holder.HolderName, last.Change)
+		}
+	}
+}
+
+func ExampleTop10Floatholders() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取浦发银行2023年年报的前十大流通股东
+	items, err := holders.Top10Floatholders(client, &holders.Top10FloatholdersParams{
+		TSCode: "600000.SH",
+		Period: "20231231",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s 持股=%.0f股 占流通股本=%.2f%%\n", item.HolderName, item.HoldAmount, item.HoldFloatRatio)
+	}
+}
+
+func ExamplePivotTop10Holders() {
+	items := []*holders.Top10HoldersItem{
+		{TSCode: "600000.SH", AnnDate: "20230830", EndDate: "20230630", HolderName: "股东A", HoldAmount: 1000},
+		{TSCode: "600000.SH", AnnDate: "20230830", EndDate: "20230630", HolderName: "股东B", HoldAmount: 800},
+		{TSCode: "600000.SH", AnnDate: "20231030", EndDate: "20230930", HolderName: "股东A", HoldAmount: 900},
+		{TSCode: "600000.SH", AnnDate: "20231030", EndDate: "20230930", HolderName: "股东C", HoldAmount: 500},
+	}
+
+	for _, pivot := range holders.PivotTop10Holders(items) {
+		fmt.Println(pivot.TSCode, pivot.Periods)
+		for _, holder := range pivot.Holders {
+			cell := holder.Cells[1]
+			fmt.Println(holder.HolderName, cell.Change, cell.ChangeAmount)
+		}
+	}
+	// Output:
+	// 600000.SH [20230630 20230930]
+	// 股东A decrease -100
+	// 股东C entry 500
+	// 股东B exit -800
+}
+
+func ExampleStkHolderNumber() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行2023年公告的股东户数
+	items, err := holders.StkHolderNumber(client, &holders.StkHolderNumberParams{
+		TSCode:    "000001.SZ",
+		StartDate: "20230101",
+		EndDate:   "20231231",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("截止 %s 股东户数=%d\n", item.EndDate, item.HolderNum)
+	}
+}
+
+func ExampleStkHolderTrade() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月公告的高管减持记录
+	items, err := holders.StkHolderTrade(client, &holders.StkHolderTradeParams{
+		StartDate:  "20240101",
+		EndDate:    "20240131",
+		TradeType:  holders.TradeTypeDecrease,
+		HolderType: holders.HolderTypeExecutive,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s %s 减持 %.0f股 均价=%.2f\n", item.TSCode, item.HolderName, item.ChangeVol, item.AvgPrice)
+	}
+}
+
+func ExamplePledgeStat() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行的股权质押统计
+	items, err := holders.PledgeStat(client, &holders.PledgeStatParams{
+		TSCode: "000001.SZ",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("截止 %s 质押比例=%.2f%%\n", item.EndDate, item.PledgeRatio)
+	}
+}
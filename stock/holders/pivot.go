@@ -0,0 +1,169 @@
+package holders
+
+import (
+	"sort"
+)
+
+// HolderChange 股东相对上一报告期的变化
+type HolderChange string
+
+const (
+	// HolderChangeNone 无法比较（第一个报告期，或两期均不在前十大股东中）
+	HolderChangeNone HolderChange = ""
+	// HolderChangeEntry 新进入前十大股东
+	HolderChangeEntry HolderChange = "entry"
+	// HolderChangeExit 退出前十大股东（不代表已清仓）
+	HolderChangeExit HolderChange = "exit"
+	// HolderChangeIncrease 增持
+	HolderChangeIncrease HolderChange = "increase"
+	// HolderChangeDecrease 减持
+	HolderChangeDecrease HolderChange = "decrease"
+	// HolderChangeUnchanged 持股不变
+	HolderChangeUnchanged HolderChange = "unchanged"
+)
+
+// Top10PivotCell 股东在某个报告期的持股情况
+type Top10PivotCell struct {
+	Held         bool         // 是否在该期前十大股东中
+	HoldAmount   float64      // 持有数量（股）
+	HoldRatio    float64      // 占总股本比例（%）
+	Change       HolderChange // 相对上一报告期的变化
+	ChangeAmount float64      // 相对上一报告期的持股变化（股），进入或退出时为全部持股
+}
+
+// Top10PivotHolder 单个股东在各报告期的持股情况
+type Top10PivotHolder struct {
+	HolderName string           // 股东名称
+	HolderType string           // 股东类型（取最近一期的记录）
+	Cells      []Top10PivotCell // 与 Top10Pivot.Periods 一一对应
+}
+
+// Top10Pivot 单只股票的前十大股东透视表：行为股东，列为报告期
+type Top10Pivot struct {
+	TSCode  string              // 股票代码
+	Periods []string            // 报告期，升序排列
+	Holders []*Top10PivotHolder // 股东，按最近一次在榜的报告期降序、该期持股数量降序排列
+}
+
+// PivotTop10Holders 将前十大股东数据按股票透视为股东 × 报告期的表，标出各期的进入、退出、增持和减持
+//
+// 同一报告期有多次公告（如更正）时只使用公告日期最新的记录；股东按名称匹配。
+// 结果按 ts_code 升序排列。
+func PivotTop10Holders(items []*Top10HoldersItem) []*Top10Pivot {
+	byCode := make(map[string][]*Top10HoldersItem)
+	var codes []string
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if _, ok := byCode[item.TSCode]; !ok {
+			codes = append(codes, item.TSCode)
+		}
+		byCode[item.TSCode] = append(byCode[item.TSCode], item)
+	}
+	sort.Strings(codes)
+
+	pivots := make([]*Top10Pivot, len(codes))
+	for i, code := range codes {
+		pivots[i] = pivotStock(code, byCode[code])
+	}
+	return pivots
+}
+
+// PivotTop10Floatholders 对前十大流通股东数据执行 PivotTop10Holders
+func PivotTop10Floatholders(items []*Top10FloatholdersItem) []*Top10Pivot {
+	converted := make([]*Top10HoldersItem, len(items))
+	for i, item := range items {
+		converted[i] = (*Top10HoldersItem)(item)
+	}
+	return PivotTop10Holders(converted)
+}
+
+// pivotStock 透视单只股票的前十大股东
+func pivotStock(code string, items []*Top10HoldersItem) *Top10Pivot {
+	// 每个报告期只保留最新公告的记录
+	latestAnn := make(map[string]string)
+	for _, item := range items {
+		if item.AnnDate > latestAnn[item.EndDate] {
+			latestAnn[item.EndDate] = item.AnnDate
+		}
+	}
+	periods := make([]string, 0, len(latestAnn))
+	for period := range latestAnn {
+		periods = append(periods, period)
+	}
+	sort.Strings(periods)
+	column := make(map[string]int, len(periods))
+	for k, period := range periods {
+		column[period] = k
+	}
+
+	pivot := &Top10Pivot{TSCode: code, Periods: periods}
+	byName := make(map[string]*Top10PivotHolder)
+	typeAt := make(map[*Top10PivotHolder]int) // HolderType 取自的报告期
+	for _, item := range items {
+		if item.AnnDate != latestAnn[item.EndDate] {
+			continue
+		}
+		holder, ok := byName[item.HolderName]
+		if !ok {
+			holder = &Top10PivotHolder{
+				HolderName: item.HolderName,
+				Cells:      make([]Top10PivotCell, len(periods)),
+			}
+			byName[item.HolderName] = holder
+			pivot.Holders = append(pivot.Holders, holder)
+		}
+		k := column[item.EndDate]
+		cell := &holder.Cells[k]
+		if k >= typeAt[holder] {
+			holder.HolderType = item.HolderType
+			typeAt[holder] = k
+		}
+		cell.Held = true
+		cell.HoldAmount += item.HoldAmount
+		cell.HoldRatio += item.HoldRatio
+	}
+
+	lastHeld := make(map[*Top10PivotHolder]int, len(pivot.Holders))
+	for _, holder := range pivot.Holders {
+		for k := range holder.Cells {
+			cell := &holder.Cells[k]
+			if cell.Held {
+				lastHeld[holder] = k
+			}
+			if k == 0 {
+				continue
+			}
+			prev := holder.Cells[k-1]
+			switch {
+			case cell.Held && !prev.Held:
+				cell.Change = HolderChangeEntry
+				cell.ChangeAmount = cell.HoldAmount
+			case !cell.Held && prev.Held:
+				cell.Change = HolderChangeExit
+				cell.ChangeAmount = -prev.HoldAmount
+			case cell.Held && prev.Held:
+				cell.ChangeAmount = cell.HoldAmount - prev.HoldAmount
+				switch {
+				case cell.ChangeAmount > 0:
+					cell.Change = HolderChangeIncrease
+				case cell.ChangeAmount < 0:
+					cell.Change = HolderChangeDecrease
+				default:
+					cell.Change = HolderChangeUnchanged
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(pivot.Holders, func(a, b int) bool {
+		ha, hb := pivot.Holders[a], pivot.Holders[b]
+		ka, kb := lastHeld[ha], lastHeld[hb]
+		if ka != kb {
+			return ka > kb
+		}
+		return ha.Cells[ka].HoldAmount > hb.Cells[kb].HoldAmount
+	})
+	return pivot
+}
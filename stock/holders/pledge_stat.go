@@ -0,0 +1,76 @@
+package holders
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// PledgeStatField 返回字段常量
+const (
+	PledgeStatFieldTSCode       = "ts_code"       // 股票代码
+	PledgeStatFieldEndDate      = "end_date"      // 截止日期
+	PledgeStatFieldPledgeCount  = "pledge_count"  // 质押次数
+	PledgeStatFieldUnrestPledge = "unrest_pledge" // 无限售股质押数量（万）
+	PledgeStatFieldRestPledge   = "rest_pledge"   // 限售股份质押数量（万）
+	PledgeStatFieldTotalShare   = "total_share"   // 总股本（万）
+	PledgeStatFieldPledgeRatio  = "pledge_ratio"  // 质押比例（%）
+)
+
+// PledgeStatParams 股权质押统计参数
+// 接口: pledge_stat
+// 描述: 获取股票质押统计数据。
+// 调用限制：单次最大1000条，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=110
+type PledgeStatParams struct {
+	TSCode  string   // 股票代码
+	EndDate string   // 截止日期（YYYYMMDD）
+	Fields  []string // 返回字段列表
+}
+
+// PledgeStatItem 股权质押统计响应项
+type PledgeStatItem struct {
+	TSCode       string  `json:"ts_code"`       // 股票代码
+	EndDate      string  `json:"end_date"`      // 截止日期
+	PledgeCount  int     `json:"pledge_count"`  // 质押次数
+	UnrestPledge float64 `json:"unrest_pledge"` // 无限售股质押数量（万）
+	RestPledge   float64 `json:"rest_pledge"`   // 限售股份质押数量（万）
+	TotalShare   float64 `json:"total_share"`   // 总股本（万）
+	PledgeRatio  float64 `json:"pledge_ratio"`  // 质押比例（%）
+}
+
+// PledgeStat 获取股权质押统计数据（自动处理分页）
+// 根据指定条件获取股票各截止日期的质押次数、质押数量和质押比例
+func PledgeStat(c tushare.Querier, params *PledgeStatParams, opts ...tushare.QueryOption) ([]*PledgeStatItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("pledge_stat", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*PledgeStatItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,82 @@
+package holders
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// StkHolderNumberField 返回字段常量
+const (
+	StkHolderNumberFieldTSCode    = "ts_code"    // 股票代码
+	StkHolderNumberFieldAnnDate   = "ann_date"   // 公告日期
+	StkHolderNumberFieldEndDate   = "end_date"   // 截止日期
+	StkHolderNumberFieldHolderNum = "holder_num" // 股东户数
+)
+
+// StkHolderNumberParams 股东人数参数
+// 接口: stk_holdernumber
+// 描述: 获取上市公司股东户数数据，数据不定期公布。
+// 调用限制：单次最大3000条，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=166
+type StkHolderNumberParams struct {
+	TSCode     string   // 股票代码
+	AnnDate    string   // 公告日期（YYYYMMDD）
+	CutoffDate string   // 截止日期（YYYYMMDD，对应接口参数 enddate）
+	StartDate  string   // 公告开始日期(YYYYMMDD)
+	EndDate    string   // 公告结束日期(YYYYMMDD)
+	Fields     []string // 返回字段列表
+}
+
+// StkHolderNumberItem 股东人数响应项
+type StkHolderNumberItem struct {
+	TSCode    string `json:"ts_code"`    // 股票代码
+	AnnDate   string `json:"ann_date"`   // 公告日期
+	EndDate   string `json:"end_date"`   // 截止日期
+	HolderNum int    `json:"holder_num"` // 股东户数
+}
+
+// StkHolderNumber 获取股东人数数据（自动处理分页）
+// 根据指定条件获取上市公司各截止日期的股东户数
+func StkHolderNumber(c tushare.Querier, params *StkHolderNumberParams, opts ...tushare.QueryOption) ([]*StkHolderNumberItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.AnnDate != "" {
+		reqParams["ann_date"] = params.AnnDate
+	}
+	if params.CutoffDate != "" {
+		reqParams["enddate"] = params.CutoffDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("stk_holdernumber", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*StkHolderNumberItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
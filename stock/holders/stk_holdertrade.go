@@ -0,0 +1,126 @@
+package holders
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// TradeType 增减持类型
+type TradeType string
+
+const (
+	// TradeTypeIncrease 增持
+	TradeTypeIncrease TradeType = "IN"
+	// TradeTypeDecrease 减持
+	TradeTypeDecrease TradeType = "DE"
+)
+
+// HolderType 股东类型
+type HolderType string
+
+const (
+	// HolderTypeExecutive 高管
+	HolderTypeExecutive HolderType = "G"
+	// HolderTypePerson 个人
+	HolderTypePerson HolderType = "P"
+	// HolderTypeCompany 公司
+	HolderTypeCompany HolderType = "C"
+)
+
+// StkHolderTradeField 返回字段常量
+const (
+	StkHolderTradeFieldTSCode      = "ts_code"      // 股票代码
+	StkHolderTradeFieldAnnDate     = "ann_date"     // 公告日期
+	StkHolderTradeFieldHolderName  = "holder_name"  // 股东名称
+	StkHolderTradeFieldHolderType  = "holder_type"  // 股东类型：G 高管，P 个人，C 公司
+	StkHolderTradeFieldInDe        = "in_de"        // 类型：IN 增持，DE 减持
+	StkHolderTradeFieldChangeVol   = "change_vol"   // 变动数量（股）
+	StkHolderTradeFieldChangeRatio = "change_ratio" // 占流通比例（%）
+	StkHolderTradeFieldAfterShare  = "after_share"  // 变动后持股（股）
+	StkHolderTradeFieldAfterRatio  = "after_ratio"  // 变动后占流通比例（%）
+	StkHolderTradeFieldAvgPrice    = "avg_price"    // 平均价格
+	StkHolderTradeFieldTotalShare  = "total_share"  // 持股总数（股）
+	StkHolderTradeFieldBeginDate   = "begin_date"   // 增减持开始日期
+	StkHolderTradeFieldCloseDate   = "close_date"   // 增减持结束日期
+)
+
+// StkHolderTradeParams 股东增减持参数
+// 接口: stk_holdertrade
+// 描述: 获取上市公司增减持数据，了解重要股东近期及历史上的股份增减变化。
+// 调用限制：单次最大提取3000行记录，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=175
+type StkHolderTradeParams struct {
+	TSCode     string     // 股票代码
+	AnnDate    string     // 公告日期（YYYYMMDD）
+	StartDate  string     // 公告开始日期(YYYYMMDD)
+	EndDate    string     // 公告结束日期(YYYYMMDD)
+	TradeType  TradeType  // 交易类型：IN 增持，DE 减持
+	HolderType HolderType // 股东类型：G 高管，P 个人，C 公司
+	Fields     []string   // 返回字段列表
+}
+
+// StkHolderTradeItem 股东增减持响应项
+type StkHolderTradeItem struct {
+	TSCode      string     `json:"ts_code"`      // 股票代码
+	AnnDate     string     `json:"ann_date"`     // 公告日期
+	HolderName  string     `json:"holder_name"`  // 股东名称
+	HolderType  HolderType `json:"holder_type"`  // 股东类型：G 高管，P 个人，C 公司
+	InDe        TradeType  `json:"in_de"`        // 类型：IN 增持，DE 减持
+	ChangeVol   float64    `json:"change_vol"`   // 变动数量（股）
+	ChangeRatio float64    `json:"change_ratio"` // 占流通比例（%）
+	AfterShare  float64    `json:"after_share"`  // 变动后持股（股）
+	AfterRatio  float64    `json:"after_ratio"`  // 变动后占流通比例（%）
+	AvgPrice    float64    `json:"avg_price"`    // 平均价格
+	TotalShare  float64    `json:"total_share"`  // 持股总数（股）
+	BeginDate   string     `json:"begin_date"`   // 增减持开始日期
+	CloseDate   string     `json:"close_date"`   // 增减持结束日期
+}
+
+// StkHolderTrade 获取股东增减持数据（自动处理分页）
+// 根据指定条件获取上市公司股东的增持、减持记录
+func StkHolderTrade(c tushare.Querier, params *StkHolderTradeParams, opts ...tushare.QueryOption) ([]*StkHolderTradeItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.AnnDate != "" {
+		reqParams["ann_date"] = params.AnnDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+	if params.TradeType != "" {
+		reqParams["trade_type"] = string(params.TradeType)
+	}
+	if params.HolderType != "" {
+		reqParams["holder_type"] = string(params.HolderType)
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("stk_holdertrade", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*StkHolderTradeItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,91 @@
+package holders
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// Top10FloatholdersField 返回字段常量
+const (
+	Top10FloatholdersFieldTSCode         = "ts_code"          // 股票代码
+	Top10FloatholdersFieldAnnDate        = "ann_date"         // 公告日期
+	Top10FloatholdersFieldEndDate        = "end_date"         // 报告期
+	Top10FloatholdersFieldHolderName     = "holder_name"      // 股东名称
+	Top10FloatholdersFieldHoldAmount     = "hold_amount"      // 持有数量（股）
+	Top10FloatholdersFieldHoldRatio      = "hold_ratio"       // 占总股本比例（%）
+	Top10FloatholdersFieldHoldFloatRatio = "hold_float_ratio" // 占流通股本比例（%）
+	Top10FloatholdersFieldHoldChange     = "hold_change"      // 持股变动（股）
+	Top10FloatholdersFieldHolderType     = "holder_type"      // 股东类型
+)
+
+// Top10FloatholdersParams 前十大流通股东参数
+// 接口: top10_floatholders
+// 描述: 获取上市公司前十大流通股东数据。
+// 文档: https://tushare.pro/document/2?doc_id=62
+type Top10FloatholdersParams struct {
+	TSCode    string   // 股票代码（必填）
+	Period    string   // 报告期（YYYYMMDD，如 20231231 表示年报）
+	AnnDate   string   // 公告日期（YYYYMMDD）
+	StartDate string   // 报告期开始日期(YYYYMMDD)
+	EndDate   string   // 报告期结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// Top10FloatholdersItem 前十大流通股东响应项
+type Top10FloatholdersItem struct {
+	TSCode         string  `json:"ts_code"`          // 股票代码
+	AnnDate        string  `json:"ann_date"`         // 公告日期
+	EndDate        string  `json:"end_date"`         // 报告期
+	HolderName     string  `json:"holder_name"`      // 股东名称
+	HoldAmount     float64 `json:"hold_amount"`      // 持有数量（股）
+	HoldRatio      float64 `json:"hold_ratio"`       // 占总股本比例（%）
+	HoldFloatRatio float64 `json:"hold_float_ratio"` // 占流通股本比例（%）
+	HoldChange     float64 `json:"hold_change"`      // 持股变动（股）
+	HolderType     string  `json:"holder_type"`      // 股东类型
+}
+
+// Top10Floatholders 获取前十大流通股东数据（自动处理分页）
+// 根据指定条件获取上市公司各报告期的前十大流通股东，可使用 PivotTop10Floatholders 按股东对比各期变化
+func Top10Floatholders(c tushare.Querier, params *Top10FloatholdersParams, opts ...tushare.QueryOption) ([]*Top10FloatholdersItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.Period != "" {
+		reqParams["period"] = params.Period
+	}
+	if params.AnnDate != "" {
+		reqParams["ann_date"] = params.AnnDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("top10_floatholders", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*Top10FloatholdersItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,93 @@
+// Package holders 提供 Tushare 股东及股权相关接口
+// 文档参考: https://tushare.pro/document/2?doc_id=61
+package holders
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// Top10HoldersField 返回字段常量
+const (
+	Top10HoldersFieldTSCode         = "ts_code"          // 股票代码
+	Top10HoldersFieldAnnDate        = "ann_date"         // 公告日期
+	Top10HoldersFieldEndDate        = "end_date"         // 报告期
+	Top10HoldersFieldHolderName     = "holder_name"      // 股东名称
+	Top10HoldersFieldHoldAmount     = "hold_amount"      // 持有数量（股）
+	Top10HoldersFieldHoldRatio      = "hold_ratio"       // 占总股本比例（%）
+	Top10HoldersFieldHoldFloatRatio = "hold_float_ratio" // 占流通股本比例（%）
+	Top10HoldersFieldHoldChange     = "hold_change"      // 持股变动（股）
+	Top10HoldersFieldHolderType     = "holder_type"      // 股东类型
+)
+
+// Top10HoldersParams 前十大股东参数
+// 接口: top10_holders
+// 描述: 获取上市公司前十大股东数据，包括持有数量和比例等信息。
+// 文档: https://tushare.pro/document/2?doc_id=61
+type Top10HoldersParams struct {
+	TSCode    string   // 股票代码（必填）
+	Period    string   // 报告期（YYYYMMDD，如 20231231 表示年报）
+	AnnDate   string   // 公告日期（YYYYMMDD）
+	StartDate string   // 报告期开始日期(YYYYMMDD)
+	EndDate   string   // 报告期结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// Top10HoldersItem 前十大股东响应项
+type Top10HoldersItem struct {
+	TSCode         string  `json:"ts_code"`          // 股票代码
+	AnnDate        string  `json:"ann_date"`         // 公告日期
+	EndDate        string  `json:"end_date"`         // 报告期
+	HolderName     string  `json:"holder_name"`      // 股东名称
+	HoldAmount     float64 `json:"hold_amount"`      // 持有数量（股）
+	HoldRatio      float64 `json:"hold_ratio"`       // 占总股本比例（%）
+	HoldFloatRatio float64 `json:"hold_float_ratio"` // 占流通股本比例（%）
+	HoldChange     float64 `json:"hold_change"`      // 持股变动（股）
+	HolderType     string  `json:"holder_type"`      // 股东类型
+}
+
+// Top10Holders 获取前十大股东数据（自动处理分页）
+// 根据指定条件获取上市公司各报告期的前十大股东，可使用 PivotTop10Holders 按股东对比各期变化
+func Top10Holders(c tushare.Querier, params *Top10HoldersParams, opts ...tushare.QueryOption) ([]*Top10HoldersItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.Period != "" {
+		reqParams["period"] = params.Period
+	}
+	if params.AnnDate != "" {
+		reqParams["ann_date"] = params.AnnDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("top10_holders", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*Top10HoldersItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
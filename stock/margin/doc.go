@@ -0,0 +1,34 @@
+// Package margin 提供 Tushare 融资融券数据接口
+//
+// 本包目前包含以下接口：
+//   - margin: 融资融券交易汇总（按交易所）
+//   - margin_detail: 融资融券交易明细（按股票）
+//
+// 文档参考:
+//   - margin: https://tushare.pro/document/2?doc_id=58
+//   - margin_detail: https://tushare.pro/document/2?doc_id=59
+//
+// 使用示例：
+//
+//	import (
+//	    tushare "github.com/fletcherlau/go-tushare"
+//	    "github.com/fletcherlau/go-tushare/stock/basic"
+//	    "github.com/fletcherlau/go-tushare/stock/margin"
+//	)
+//
+//	func main() {
+//	    client := tushare.NewClient("your_token")
+//
+//	    // 获取上交所融资融券汇总
+//	    items, err := margin.Margin(client, &margin.MarginParams{
+//	        ExchangeID: basic.ExchangeSSE,
+//	        StartDate:  "20240101",
+//	        EndDate:    "20240131",
+//	    })
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//
+//	    fmt.Printf("获取 %d 条记录\n", len(items))
+//	}
+package margin
@@ -0,0 +1,55 @@
+package margin_test
+
+import (
+	"fmt"
+	"log"
+
+	tushare "github.com/fletcherlau/go-tushare"
+	"github.com/fletcherlau/go-tushare/stock/basic"
+	"github.com/fletcherlau/go-tushare/stock/margin"
+)
+
+func ExampleMargin() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取深交所2024年1月的融资融券汇总
+	items, err := margin.Margin(client, &margin.MarginParams{
+		ExchangeID: basic.ExchangeSZSE,
+		StartDate:  "20240101",
+		EndDate:    "20240131",
+		Fields: []string{
+			margin.MarginFieldTradeDate,
+			margin.MarginFieldRzye,
+			margin.MarginFieldRzmre,
+			margin.MarginFieldRzrqye,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+	if len(items) > 0 {
+		fmt.Printf("第一条: 日期=%s 融资余额=%.0f元\n", items[0].TradeDate, items[0].Rzye)
+	}
+}
+
+func ExampleMarginDetail() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行2024年1月的融资融券明细
+	items, err := margin.MarginDetail(client, &margin.MarginDetailParams{
+		TSCode:    "000001.SZ",
+		StartDate: "20240101",
+		EndDate:   "20240131",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s 融资买入额=%.0f元 融券卖出量=%.0f股\n", item.TradeDate, item.Rzmre, item.Rqmcl)
+	}
+}
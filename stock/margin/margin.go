@@ -0,0 +1,91 @@
+// Package margin 提供 Tushare 融资融券相关接口
+// 文档参考: https://tushare.pro/document/2?doc_id=58
+package margin
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+	"github.com/fletcherlau/go-tushare/stock/basic"
+)
+
+// MarginField 返回字段常量
+const (
+	MarginFieldTradeDate  = "trade_date"  // 交易日期
+	MarginFieldExchangeID = "exchange_id" // 交易所代码
+	MarginFieldRzye       = "rzye"        // 融资余额（元）
+	MarginFieldRzmre      = "rzmre"       // 融资买入额（元）
+	MarginFieldRzche      = "rzche"       // 融资偿还额（元）
+	MarginFieldRqye       = "rqye"        // 融券余额（元）
+	MarginFieldRqmcl      = "rqmcl"       // 融券卖出量（股，份，手）
+	MarginFieldRzrqye     = "rzrqye"      // 融资融券余额（元）
+	MarginFieldRqyl       = "rqyl"        // 融券余量（股，份，手）
+)
+
+// MarginParams 融资融券交易汇总参数
+// 接口: margin
+// 描述: 获取融资融券每日交易汇总数据，按交易所统计。
+// 调用限制：单次请求最大返回4000行数据，可根据日期循环。
+// 文档: https://tushare.pro/document/2?doc_id=58
+type MarginParams struct {
+	TradeDate  string         // 交易日期（YYYYMMDD）
+	StartDate  string         // 开始日期(YYYYMMDD)
+	EndDate    string         // 结束日期(YYYYMMDD)
+	ExchangeID basic.Exchange // 交易所代码（SSE 上交所，SZSE 深交所，BSE 北交所）
+	Fields     []string       // 返回字段列表
+}
+
+// MarginItem 融资融券交易汇总响应项
+type MarginItem struct {
+	TradeDate  string         `json:"trade_date"`  // 交易日期
+	ExchangeID basic.Exchange `json:"exchange_id"` // 交易所代码
+	Rzye       float64        `json:"rzye"`        // 融资余额（元）
+	Rzmre      float64        `json:"rzmre"`       // 融资买入额（元）
+	Rzche      float64        `json:"rzche"`       // 融资偿还额（元）
+	Rqye       float64        `json:"rqye"`        // 融券余额（元）
+	Rqmcl      float64        `json:"rqmcl"`       // 融券卖出量（股，份，手）
+	Rzrqye     float64        `json:"rzrqye"`      // 融资融券余额（元）
+	Rqyl       float64        `json:"rqyl"`        // 融券余量（股，份，手）
+}
+
+// Margin 获取融资融券交易汇总数据（自动处理分页）
+// 根据指定条件获取各交易所每日的融资融券汇总
+func Margin(c tushare.Querier, params *MarginParams, opts ...tushare.QueryOption) ([]*MarginItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+	if params.ExchangeID != "" {
+		reqParams["exchange_id"] = string(params.ExchangeID)
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("margin", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*MarginItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,92 @@
+package margin
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// MarginDetailField 返回字段常量
+const (
+	MarginDetailFieldTradeDate = "trade_date" // 交易日期
+	MarginDetailFieldTSCode    = "ts_code"    // 股票代码
+	MarginDetailFieldName      = "name"       // 股票名称
+	MarginDetailFieldRzye      = "rzye"       // 融资余额（元）
+	MarginDetailFieldRqye      = "rqye"       // 融券余额（元）
+	MarginDetailFieldRzmre     = "rzmre"      // 融资买入额（元）
+	MarginDetailFieldRqyl      = "rqyl"       // 融券余量（股）
+	MarginDetailFieldRzche     = "rzche"      // 融资偿还额（元）
+	MarginDetailFieldRqchl     = "rqchl"      // 融券偿还量（股）
+	MarginDetailFieldRqmcl     = "rqmcl"      // 融券卖出量（股，份，手）
+	MarginDetailFieldRzrqye    = "rzrqye"     // 融资融券余额（元）
+)
+
+// MarginDetailParams 融资融券交易明细参数
+// 接口: margin_detail
+// 描述: 获取沪深两市每日融资融券明细。
+// 调用限制：单次请求最大返回6000行数据，可根据日期循环。
+// 文档: https://tushare.pro/document/2?doc_id=59
+type MarginDetailParams struct {
+	TSCode    string   // 股票代码
+	TradeDate string   // 交易日期（YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// MarginDetailItem 融资融券交易明细响应项
+type MarginDetailItem struct {
+	TradeDate string  `json:"trade_date"` // 交易日期
+	TSCode    string  `json:"ts_code"`    // 股票代码
+	Name      string  `json:"name"`       // 股票名称
+	Rzye      float64 `json:"rzye"`       // 融资余额（元）
+	Rqye      float64 `json:"rqye"`       // 融券余额（元）
+	Rzmre     float64 `json:"rzmre"`      // 融资买入额（元）
+	Rqyl      float64 `json:"rqyl"`       // 融券余量（股）
+	Rzche     float64 `json:"rzche"`      // 融资偿还额（元）
+	Rqchl     float64 `json:"rqchl"`      // 融券偿还量（股）
+	Rqmcl     float64 `json:"rqmcl"`      // 融券卖出量（股，份，手）
+	Rzrqye    float64 `json:"rzrqye"`     // 融资融券余额（元）
+}
+
+// MarginDetail 获取融资融券交易明细数据（自动处理分页）
+// 根据指定条件获取个股每日的融资余额、融资买入额和融券卖出量等
+func MarginDetail(c tushare.Querier, params *MarginDetailParams, opts ...tushare.QueryOption) ([]*MarginDetailItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("margin_detail", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*MarginDetailItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -33,7 +33,7 @@ type AdjFactorItem struct {
 }
 
 // AdjFactor 获取复权因子数据（自动处理分页）
-func AdjFactor(c *tushare.Client, params *AdjFactorParams, opts ...tushare.QueryOption) ([]*AdjFactorItem, error) {
+func AdjFactor(c tushare.Querier, params *AdjFactorParams, opts ...tushare.QueryOption) ([]*AdjFactorItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
@@ -0,0 +1,160 @@
+package market
+
+import (
+	"fmt"
+	"sort"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// Adjust 复权方式
+type Adjust string
+
+const (
+	// AdjustNone 不复权
+	AdjustNone Adjust = ""
+	// AdjustForward 前复权：以查询区间内最新的复权因子为基准，最新价格与不复权价格一致
+	AdjustForward Adjust = "qfq"
+	// AdjustBackward 后复权：价格乘以当日复权因子，历史价格保持不变
+	AdjustBackward Adjust = "hfq"
+)
+
+// AdjustedDaily 获取复权日线行情（自动处理分页）
+//
+// 分别获取 daily 和 adj_factor 后按 ts_code、trade_date 对齐并计算复权价格，
+// 相当于 Python SDK 的 pro_bar(adj="qfq"/"hfq")，规则见 ApplyAdjust。
+// adjust 为 AdjustNone 时等价于 Daily。
+func AdjustedDaily(c tushare.Querier, params *DailyParams, adjust Adjust, opts ...tushare.QueryOption) ([]*DailyItem, error) {
+	switch adjust {
+	case AdjustNone, AdjustForward, AdjustBackward:
+	default:
+		return nil, fmt.Errorf("unsupported adjust: %q", adjust)
+	}
+
+	dailyParams := *params
+	if len(params.Fields) > 0 {
+		// 对齐复权因子需要股票代码和交易日期
+		dailyParams.Fields = appendMissing(params.Fields, DailyFieldTSCode, DailyFieldTradeDate)
+	}
+	items, err := Daily(c, &dailyParams, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if adjust == AdjustNone {
+		return items, nil
+	}
+
+	factors, err := AdjFactor(c, &AdjFactorParams{
+		TSCode:    params.TSCode,
+		TradeDate: params.TradeDate,
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ApplyAdjust(items, factors, adjust)
+}
+
+// ApplyAdjust 使用复权因子计算复权日线，返回新的切片（顺序与 items 相同，不修改 items）
+//
+// 复权 open/high/low/close/pre_close，并重新计算 change 和 pct_chg：
+//   - 后复权：价格 × 当日复权因子
+//   - 前复权：价格 × 当日复权因子 ÷ 该股票在 items 范围内最新交易日的复权因子
+//
+// 某日缺少复权因子时沿用该股票之前最近一日的因子（之前没有时使用之后最近一日的因子）；
+// 股票完全没有复权因子时返回错误。
+func ApplyAdjust(items []*DailyItem, factors []*AdjFactorItem, adjust Adjust) ([]*DailyItem, error) {
+	switch adjust {
+	case AdjustForward, AdjustBackward:
+	case AdjustNone:
+		result := make([]*DailyItem, len(items))
+		for i, item := range items {
+			if item != nil {
+				copied := *item
+				result[i] = &copied
+			}
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported adjust: %q", adjust)
+	}
+
+	byCode := make(map[string][]*AdjFactorItem)
+	for _, f := range factors {
+		if f != nil {
+			byCode[f.TSCode] = append(byCode[f.TSCode], f)
+		}
+	}
+	for _, list := range byCode {
+		sort.Slice(list, func(a, b int) bool { return list[a].TradeDate < list[b].TradeDate })
+	}
+
+	// 前复权的基准：每只股票在 items 中最新交易日的因子
+	latest := make(map[string]string)
+	for _, item := range items {
+		if item != nil && item.TradeDate > latest[item.TSCode] {
+			latest[item.TSCode] = item.TradeDate
+		}
+	}
+
+	result := make([]*DailyItem, len(items))
+	for i, item := range items {
+		if item == nil {
+			continue
+		}
+		factor, ok := factorOn(byCode[item.TSCode], item.TradeDate)
+		if !ok {
+			return nil, fmt.Errorf("no adj_factor for %s", item.TSCode)
+		}
+		if adjust == AdjustForward {
+			base, _ := factorOn(byCode[item.TSCode], latest[item.TSCode])
+			factor /= base
+		}
+
+		adjusted := *item
+		adjusted.Open *= factor
+		adjusted.High *= factor
+		adjusted.Low *= factor
+		adjusted.Close *= factor
+		adjusted.PreClose *= factor
+		adjusted.Change = adjusted.Close - adjusted.PreClose
+		if adjusted.PreClose != 0 {
+			adjusted.PctChg = adjusted.Change / adjusted.PreClose * 100
+		}
+		result[i] = &adjusted
+	}
+	return result, nil
+}
+
+// factorOn 返回 date 当日的复权因子，缺失时取之前最近一日，之前没有时取之后最近一日
+// factors 需按 trade_date 升序排列
+func factorOn(factors []*AdjFactorItem, date string) (float64, bool) {
+	if len(factors) == 0 {
+		return 0, false
+	}
+	k := sort.Search(len(factors), func(k int) bool { return factors[k].TradeDate > date })
+	if k == 0 {
+		return factors[0].AdjFactor, true
+	}
+	return factors[k-1].AdjFactor, true
+}
+
+// appendMissing 在字段列表末尾追加缺少的字段
+func appendMissing(fields []string, required ...string) []string {
+	result := append([]string(nil), fields...)
+	for _, r := range required {
+		found := false
+		for _, f := range fields {
+			if f == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, r)
+		}
+	}
+	return result
+}
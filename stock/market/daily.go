@@ -53,7 +53,7 @@ type DailyItem struct {
 
 // Daily 获取A股日线行情数据（自动处理分页）
 // 根据指定条件获取股票的日线行情数据
-func Daily(c *tushare.Client, params *DailyParams, opts ...tushare.QueryOption) ([]*DailyItem, error) {
+func Daily(c tushare.Querier, params *DailyParams, opts ...tushare.QueryOption) ([]*DailyItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
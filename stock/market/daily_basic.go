@@ -63,7 +63,7 @@ type DailyBasicItem struct {
 }
 
 // DailyBasic 获取每日指标数据（自动处理分页）
-func DailyBasic(c *tushare.Client, params *DailyBasicParams, opts ...tushare.QueryOption) ([]*DailyBasicItem, error) {
+func DailyBasic(c tushare.Querier, params *DailyBasicParams, opts ...tushare.QueryOption) ([]*DailyBasicItem, error) {
 	reqParams := make(map[string]interface{})
 	if params.TSCode != "" {
 		reqParams["ts_code"] = params.TSCode
@@ -2,13 +2,32 @@
 //
 // 本包目前包含以下接口：
 //   - daily: A股日线行情
+//   - weekly: A股周线行情
+//   - monthly: A股月线行情
+//   - stk_weekly_monthly: 股票周/月线行情（每日更新）
+//   - stk_mins: A股分钟行情（按时间自动分段查询）
 //   - adj_factor: 复权因子
 //   - daily_basic: 每日指标
+//   - stk_limit: 每日涨跌停价格
+//   - suspend_d: 每日停复牌信息
+//   - limit_list_d: 涨跌停列表（涨停、跌停、炸板）
+//
+// 以及以下工具：
+//   - Resample/ResampleDays/ResampleDataFrame: 将日线合成周、月、季度或 N 个交易日的 K 线
+//   - AdjustedDaily/ApplyAdjust: 由 daily 和 adj_factor 计算前复权、后复权日线
+//   - Tradability/CombineTradability: 由 stk_limit 和 suspend_d 得到每日可交易状态
 //
 // 文档参考:
 //   - daily: https://tushare.pro/document/2?doc_id=27
+//   - weekly: https://tushare.pro/document/2?doc_id=144
+//   - monthly: https://tushare.pro/document/2?doc_id=145
+//   - stk_weekly_monthly: https://tushare.pro/document/2?doc_id=336
+//   - stk_mins: https://tushare.pro/document/2?doc_id=370
 //   - adj_factor: https://tushare.pro/document/2?doc_id=28
 //   - daily_basic: https://tushare.pro/document/2?doc_id=32
+//   - stk_limit: https://tushare.pro/document/2?doc_id=183
+//   - suspend_d: https://tushare.pro/document/2?doc_id=214
+//   - limit_list_d: https://tushare.pro/document/2?doc_id=298
 //
 // 使用示例：
 //
@@ -3,8 +3,11 @@ package market_test
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	tushare "github.com/fletcherlau/go-tushare"
+	"github.com/fletcherlau/go-tushare/stock/basic"
 	"github.com/fletcherlau/go-tushare/stock/market"
 )
 
@@ -38,6 +41,53 @@ func ExampleDaily() {
 	}
 }
 
+// stkMinsStub 模拟 stk_mins 接口：打印每次调用的参数，并为每只股票返回分段起点的一根 K 线
+type stkMinsStub struct{}
+
+func (stkMinsStub) Query(apiName string, params map[string]interface{}, fields string, opts ...tushare.QueryOption) (*tushare.Response, error) {
+	fmt.Printf("调用 %s ts_code=%s %s ~ %s\n", apiName, params["ts_code"], params["start_date"], params["end_date"])
+	var items [][]interface{}
+	for _, code := range strings.Split(params["ts_code"].(string), ",") {
+		items = append(items, []interface{}{code, params["start_date"], 10.5})
+	}
+	return &tushare.Response{Data: &tushare.ResponseData{
+		Fields: []string{"ts_code", "trade_time", "close"},
+		Items:  items,
+	}}, nil
+}
+
+func (q stkMinsStub) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...tushare.QueryOption) (*tushare.Response, error) {
+	return q.Query(apiName, params, fields, opts...)
+}
+
+func ExampleStkMins() {
+	// 实际使用时传入 tushare.NewClient("your_token")，这里用模拟接口展示分段查询
+	var client tushare.Querier = stkMinsStub{}
+
+	// 获取两只股票 2024 年前两个月的 1 分钟线：每天约 241 根，每段 8000/(241*2) = 16 天
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, tushare.Shanghai)
+	items, err := market.StkMins(client, &market.StkMinsParams{
+		TSCode:    "600000.SH,000001.SZ",
+		Freq:      market.StkMinsFreq1Min,
+		StartDate: start,
+		EndDate:   time.Date(2024, 2, 20, 15, 0, 0, 0, tushare.Shanghai),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+	fmt.Printf("第一条: %s 时间=%s 收盘=%.2f\n", items[0].TSCode, items[0].TradeTime, items[0].Close)
+
+	// Output:
+	// 调用 stk_mins ts_code=600000.SH,000001.SZ 2024-01-01 09:00:00 ~ 2024-01-17 08:59:59
+	// 调用 stk_mins ts_code=600000.SH,000001.SZ 2024-01-17 09:00:00 ~ 2024-02-02 08:59:59
+	// 调用 stk_mins ts_code=600000.SH,000001.SZ 2024-02-02 09:00:00 ~ 2024-02-18 08:59:59
+	// 调用 stk_mins ts_code=600000.SH,000001.SZ 2024-02-18 09:00:00 ~ 2024-02-20 15:00:00
+	// 获取 8 条记录
+	// 第一条: 000001.SZ 时间=2024-01-01 09:00:00 收盘=10.50
+}
+
 func ExampleAdjFactor() {
 	// 创建客户端
 	client := tushare.NewClient("your_token")
@@ -90,3 +140,293 @@ func ExampleDailyBasic() {
 			items[0].TradeDate, items[0].Close, items[0].PE, items[0].PB, items[0].TotalMV)
 	}
 }
+
+func ExampleWeekly() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行2024年上半年的周线行情
+	items, err := market.Weekly(client, &market.WeeklyParams{
+		TSCode:    "000001.SZ",
+		StartDate: "20240101",
+		EndDate:   "20240630",
+		Fields: []string{
+			market.WeeklyFieldTradeDate,
+			market.WeeklyFieldOpen,
+			market.WeeklyFieldClose,
+			market.WeeklyFieldPctChg,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+	if len(items) > 0 {
+		fmt.Printf("第一条: 日期=%s 收盘=%.2f 涨跌幅=%.2f%%\n",
+			items[0].TradeDate, items[0].Close, items[0].PctChg)
+	}
+}
+
+func ExampleMonthly() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月所有股票的月线行情
+	items, err := market.Monthly(client, &market.MonthlyParams{
+		TradeDate: "20240131",
+		Fields: []string{
+			market.MonthlyFieldTSCode,
+			market.MonthlyFieldClose,
+			market.MonthlyFieldPctChg,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+}
+
+func ExampleStkWeeklyMonthly() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取截至2024年1月17日的本周周线（每日更新）
+	items, err := market.StkWeeklyMonthly(client, &market.StkWeeklyMonthlyParams{
+		TSCode:    "000001.SZ",
+		TradeDate: "20240117",
+		Freq:      market.StkWeeklyMonthlyFreqWeek,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s 截至 %s: 开盘=%.2f 收盘=%.2f\n",
+			item.TSCode, item.EndDate, item.Open, item.Close)
+	}
+}
+
+func ExampleStkLimit() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行2024年1月的涨跌停价格
+	items, err := market.StkLimit(client, &market.StkLimitParams{
+		TSCode:    "000001.SZ",
+		StartDate: "20240101",
+		EndDate:   "20240131",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+	if len(items) > 0 {
+		fmt.Printf("第一条: 日期=%s 涨停价=%.2f 跌停价=%.2f\n",
+			items[0].TradeDate, items[0].UpLimit, items[0].DownLimit)
+	}
+}
+
+func ExampleSuspendD() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月2日停牌的股票
+	items, err := market.SuspendD(client, &market.SuspendDParams{
+		TradeDate:   "20240102",
+		SuspendType: market.SuspendTypeSuspend,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+}
+
+func ExampleCombineTradability() {
+	limits := []*market.StkLimitItem{
+		{TSCode: "000001.SZ", TradeDate: "20240102", PreClose: 10, UpLimit: 11, DownLimit: 9},
+		{TSCode: "000001.SZ", TradeDate: "20240103", PreClose: 11, UpLimit: 12.1, DownLimit: 9.9},
+	}
+	suspends := []*market.SuspendDItem{
+		{TSCode: "000001.SZ", TradeDate: "20240103", SuspendType: market.SuspendTypeSuspend},
+	}
+
+	for _, t := range market.CombineTradability(limits, suspends) {
+		fmt.Println(t.TradeDate, t.Suspended, t.CanBuy(11), t.CanSell(11))
+	}
+	// Output:
+	// 20240102 false false true
+	// 20240103 true false false
+}
+
+func ExampleTradability() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 回测前获取股票每日的可交易状态，撮合时拒绝涨跌停和停牌日的成交
+	items, err := market.Tradability(client, &market.TradabilityParams{
+		TSCodes:   []string{"000001.SZ", "600000.SH"},
+		StartDate: "20240101",
+		EndDate:   "20240131",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, t := range items {
+		if t.Suspended {
+			fmt.Printf("%s %s 停牌\n", t.TSCode, t.TradeDate)
+		}
+	}
+}
+
+func ExampleLimitListD() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月2日的涨停股票
+	items, err := market.LimitListD(client, &market.LimitListDParams{
+		TradeDate: "20240102",
+		LimitType: market.LimitTypeUp,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s %s %d连板 首次封板=%s 炸板%d次\n", item.TSCode, item.Name,
+			item.LimitTimes, item.FirstTime.Format("15:04:05"), item.OpenTimes)
+	}
+}
+
+func ExampleParseLimitTime() {
+	t, err := market.ParseLimitTime(tushare.NewDate(2024, time.January, 2), "93000")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(t.Format("2006-01-02 15:04:05 -0700"))
+
+	// 转换为 DataFrame 时保留时分秒，没有封板时间的记录为空值
+	df, err := tushare.DataFrameFromStructs([]*market.LimitListDItem{
+		{TSCode: "000001.SZ", TradeDate: tushare.NewDate(2024, time.January, 2), FirstTime: t},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(df.GetString(0, "first_time"), df.IsNull(0, "last_time"))
+
+	// Output:
+	// 2024-01-02 09:30:00 +0800
+	// 2024-01-02 09:30:00 true
+}
+
+func ExampleResample() {
+	// 交易日历：2024-01-01 元旦休市
+	var cal []*basic.TradeCalItem
+	for _, d := range []string{"20240101", "20240102", "20240103", "20240104", "20240105", "20240108", "20240109"} {
+		isOpen := basic.TradeCalIsOpenYes
+		if d == "20240101" {
+			isOpen = basic.TradeCalIsOpenNo
+		}
+		cal = append(cal, &basic.TradeCalItem{Exchange: basic.TradeCalExchangeSSE, CalDate: d, IsOpen: isOpen})
+	}
+
+	// 日线数据（Tushare 按日期降序返回，20240105 停牌）
+	daily := []*market.DailyItem{
+		{TSCode: "000001.SZ", TradeDate: "20240109", Open: 9.5, High: 9.8, Low: 9.4, Close: 9.7, PreClose: 9.5, Vol: 300, Amount: 2900},
+		{TSCode: "000001.SZ", TradeDate: "20240108", Open: 9.3, High: 9.6, Low: 9.2, Close: 9.5, PreClose: 9.3, Vol: 200, Amount: 1900},
+		{TSCode: "000001.SZ", TradeDate: "20240104", Open: 9.1, High: 9.4, Low: 9.0, Close: 9.3, PreClose: 9.1, Vol: 150, Amount: 1400},
+		{TSCode: "000001.SZ", TradeDate: "20240103", Open: 9.2, High: 9.3, Low: 8.9, Close: 9.1, PreClose: 9.2, Vol: 120, Amount: 1100},
+		{TSCode: "000001.SZ", TradeDate: "20240102", Open: 9.0, High: 9.2, Low: 8.8, Close: 9.2, PreClose: 9.0, Vol: 100, Amount: 900},
+	}
+
+	weekly, err := market.Resample(daily, cal, market.PeriodWeekly)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, bar := range weekly {
+		fmt.Printf("%s %s O=%.2f H=%.2f L=%.2f C=%.2f 昨收=%.2f 涨跌幅=%.2f%% 量=%.0f\n",
+			bar.TSCode, bar.TradeDate, bar.Open, bar.High, bar.Low, bar.Close, bar.PreClose, bar.PctChg, bar.Vol)
+	}
+
+	// 每 3 个交易日一根 K 线
+	bars, err := market.ResampleDays(daily, cal, 3)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, bar := range bars {
+		fmt.Printf("%s C=%.2f 量=%.0f\n", bar.TradeDate, bar.Close, bar.Vol)
+	}
+
+	// DataFrame 同样可以按 N 个交易日合成
+	df, err := tushare.DataFrameFromStructs(daily)
+	if err != nil {
+		log.Fatal(err)
+	}
+	barsDF, err := market.ResampleDaysDataFrame(df, cal, 3)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < barsDF.Len(); i++ {
+		fmt.Printf("%s C=%.2f 量=%.0f\n", barsDF.GetString(i, "trade_date"), barsDF.GetFloat64(i, "close"), barsDF.GetFloat64(i, "vol"))
+	}
+
+	// Output:
+	// 000001.SZ 20240105 O=9.00 H=9.40 L=8.80 C=9.30 昨收=9.00 涨跌幅=3.33% 量=370
+	// 000001.SZ 20240109 O=9.30 H=9.80 L=9.20 C=9.70 昨收=9.30 涨跌幅=4.30% 量=500
+	// 20240104 C=9.30 量=370
+	// 20240109 C=9.70 量=500
+	// 20240104 C=9.30 量=370
+	// 20240109 C=9.70 量=500
+}
+
+func ExampleAdjustedDaily() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行2024年的前复权日线
+	items, err := market.AdjustedDaily(client, &market.DailyParams{
+		TSCode:    "000001.SZ",
+		StartDate: "20240101",
+		EndDate:   "20241231",
+	}, market.AdjustForward)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+}
+
+func ExampleApplyAdjust() {
+	// 20240103 除权（复权因子由 100 变为 110），20240104 缺少复权因子
+	daily := []*market.DailyItem{
+		{TSCode: "000001.SZ", TradeDate: "20240104", Open: 10.0, High: 10.4, Low: 9.9, Close: 10.2, PreClose: 10.0},
+		{TSCode: "000001.SZ", TradeDate: "20240103", Open: 9.9, High: 10.1, Low: 9.8, Close: 10.0, PreClose: 10.0},
+		{TSCode: "000001.SZ", TradeDate: "20240102", Open: 10.8, High: 11.1, Low: 10.7, Close: 11.0, PreClose: 10.8},
+	}
+	factors := []*market.AdjFactorItem{
+		{TSCode: "000001.SZ", TradeDate: "20240103", AdjFactor: 110},
+		{TSCode: "000001.SZ", TradeDate: "20240102", AdjFactor: 100},
+	}
+
+	qfq, err := market.ApplyAdjust(daily, factors, market.AdjustForward)
+	if err != nil {
+		log.Fatal(err)
+	}
+	hfq, err := market.ApplyAdjust(daily, factors, market.AdjustBackward)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := range daily {
+		fmt.Printf("%s 前复权收盘=%.2f 后复权收盘=%.2f 涨跌幅=%.2f%%\n",
+			qfq[i].TradeDate, qfq[i].Close, hfq[i].Close, qfq[i].PctChg)
+	}
+
+	// Output:
+	// 20240104 前复权收盘=10.20 后复权收盘=1122.00 涨跌幅=2.00%
+	// 20240103 前复权收盘=10.00 后复权收盘=1100.00 涨跌幅=0.00%
+	// 20240102 前复权收盘=10.00 后复权收盘=1100.00 涨跌幅=1.85%
+}
@@ -0,0 +1,221 @@
+package market
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// LimitType 涨跌停类型
+type LimitType string
+
+const (
+	// LimitTypeUp 涨停
+	LimitTypeUp LimitType = "U"
+	// LimitTypeDown 跌停
+	LimitTypeDown LimitType = "D"
+	// LimitTypeBroken 炸板（曾涨停但收盘未封住）
+	LimitTypeBroken LimitType = "Z"
+)
+
+// LimitExchange 涨跌停列表的交易所代码
+type LimitExchange string
+
+const (
+	// LimitExchangeSH 上交所
+	LimitExchangeSH LimitExchange = "SH"
+	// LimitExchangeSZ 深交所
+	LimitExchangeSZ LimitExchange = "SZ"
+	// LimitExchangeBJ 北交所
+	LimitExchangeBJ LimitExchange = "BJ"
+)
+
+// LimitListDField 返回字段常量
+const (
+	LimitListDFieldTradeDate     = "trade_date"     // 交易日期
+	LimitListDFieldTSCode        = "ts_code"        // 股票代码
+	LimitListDFieldIndustry      = "industry"       // 所属行业
+	LimitListDFieldName          = "name"           // 股票名称
+	LimitListDFieldClose         = "close"          // 收盘价
+	LimitListDFieldPctChg        = "pct_chg"        // 涨跌幅
+	LimitListDFieldAmount        = "amount"         // 成交额
+	LimitListDFieldLimitAmount   = "limit_amount"   // 板上成交金额（涨停无此数据）
+	LimitListDFieldFloatMv       = "float_mv"       // 流通市值
+	LimitListDFieldTotalMv       = "total_mv"       // 总市值
+	LimitListDFieldTurnoverRatio = "turnover_ratio" // 换手率
+	LimitListDFieldFdAmount      = "fd_amount"      // 封单金额
+	LimitListDFieldFirstTime     = "first_time"     // 首次封板时间（HHMMSS）
+	LimitListDFieldLastTime      = "last_time"      // 最后封板时间（HHMMSS）
+	LimitListDFieldOpenTimes     = "open_times"     // 炸板次数
+	LimitListDFieldUpStat        = "up_stat"        // 涨停统计（N/T：T 天内有 N 次涨停）
+	LimitListDFieldLimitTimes    = "limit_times"    // 连板数
+	LimitListDFieldLimit         = "limit"          // 涨跌停类型：U 涨停，D 跌停，Z 炸板
+)
+
+// LimitListDParams 涨跌停列表参数
+// 接口: limit_list_d
+// 描述: 获取A股每日涨跌停、炸板数据情况，数据从2020年开始（不提供ST股票的统计）。
+// 调用限制：单次最大可以获取2500条数据，可通过日期或者股票循环提取。
+// 文档: https://tushare.pro/document/2?doc_id=298
+type LimitListDParams struct {
+	TradeDate string        // 交易日期（YYYYMMDD）
+	TSCode    string        // 股票代码
+	LimitType LimitType     // 涨跌停类型：U 涨停，D 跌停，Z 炸板
+	Exchange  LimitExchange // 交易所：SH 上交所，SZ 深交所，BJ 北交所
+	StartDate string        // 开始日期(YYYYMMDD)
+	EndDate   string        // 结束日期(YYYYMMDD)
+	Fields    []string      // 返回字段列表
+}
+
+// LimitListDItem 涨跌停列表响应项
+type LimitListDItem struct {
+	TradeDate     tushare.Date     `json:"trade_date"`     // 交易日期
+	TSCode        string           `json:"ts_code"`        // 股票代码
+	Industry      string           `json:"industry"`       // 所属行业
+	Name          string           `json:"name"`           // 股票名称
+	Close         float64          `json:"close"`          // 收盘价
+	PctChg        float64          `json:"pct_chg"`        // 涨跌幅
+	Amount        float64          `json:"amount"`         // 成交额
+	LimitAmount   float64          `json:"limit_amount"`   // 板上成交金额（涨停无此数据）
+	FloatMv       float64          `json:"float_mv"`       // 流通市值
+	TotalMv       float64          `json:"total_mv"`       // 总市值
+	TurnoverRatio float64          `json:"turnover_ratio"` // 换手率
+	FdAmount      float64          `json:"fd_amount"`      // 封单金额
+	FirstTime     tushare.DateTime `json:"first_time"`     // 首次封板时间（交易日当天的北京时间，无数据时为零值）
+	LastTime      tushare.DateTime `json:"last_time"`      // 最后封板时间（交易日当天的北京时间，无数据时为零值）
+	OpenTimes     int              `json:"open_times"`     // 炸板次数
+	UpStat        string           `json:"up_stat"`        // 涨停统计（N/T：T 天内有 N 次涨停）
+	LimitTimes    int              `json:"limit_times"`    // 连板数
+	Limit         LimitType        `json:"limit"`          // 涨跌停类型：U 涨停，D 跌停，Z 炸板
+}
+
+// limitListDRow 接口返回的原始行，封板时间为 HHMMSS 字符串
+type limitListDRow struct {
+	TradeDate     tushare.Date `json:"trade_date"`
+	TSCode        string       `json:"ts_code"`
+	Industry      string       `json:"industry"`
+	Name          string       `json:"name"`
+	Close         float64      `json:"close"`
+	PctChg        float64      `json:"pct_chg"`
+	Amount        float64      `json:"amount"`
+	LimitAmount   float64      `json:"limit_amount"`
+	FloatMv       float64      `json:"float_mv"`
+	TotalMv       float64      `json:"total_mv"`
+	TurnoverRatio float64      `json:"turnover_ratio"`
+	FdAmount      float64      `json:"fd_amount"`
+	FirstTime     string       `json:"first_time"`
+	LastTime      string       `json:"last_time"`
+	OpenTimes     int          `json:"open_times"`
+	UpStat        string       `json:"up_stat"`
+	LimitTimes    int          `json:"limit_times"`
+	Limit         LimitType    `json:"limit"`
+}
+
+// LimitListD 获取涨跌停列表数据（自动处理分页）
+// 根据指定条件获取每日涨停、跌停和炸板股票，首次和最后封板时间解析为交易日当天的北京时间；
+// Fields 包含封板时间时会自动请求 trade_date
+func LimitListD(c tushare.Querier, params *LimitListDParams, opts ...tushare.QueryOption) ([]*LimitListDItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.LimitType != "" {
+		reqParams["limit_type"] = string(params.LimitType)
+	}
+	if params.Exchange != "" {
+		reqParams["exchange"] = string(params.Exchange)
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		requested := params.Fields
+		for _, f := range params.Fields {
+			if f == LimitListDFieldFirstTime || f == LimitListDFieldLastTime {
+				// 封板时间需要结合交易日期解析
+				requested = appendMissing(params.Fields, LimitListDFieldTradeDate)
+				break
+			}
+		}
+		fields = strings.Join(requested, ",")
+	}
+
+	resp, err := c.Query("limit_list_d", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var rows []*limitListDRow
+	if err := resp.ToStruct(&rows); err != nil {
+		return nil, err
+	}
+
+	items := make([]*LimitListDItem, len(rows))
+	for i, row := range rows {
+		item := &LimitListDItem{
+			TradeDate:     row.TradeDate,
+			TSCode:        row.TSCode,
+			Industry:      row.Industry,
+			Name:          row.Name,
+			Close:         row.Close,
+			PctChg:        row.PctChg,
+			Amount:        row.Amount,
+			LimitAmount:   row.LimitAmount,
+			FloatMv:       row.FloatMv,
+			TotalMv:       row.TotalMv,
+			TurnoverRatio: row.TurnoverRatio,
+			FdAmount:      row.FdAmount,
+			OpenTimes:     row.OpenTimes,
+			UpStat:        row.UpStat,
+			LimitTimes:    row.LimitTimes,
+			Limit:         row.Limit,
+		}
+		if item.FirstTime, err = ParseLimitTime(row.TradeDate, row.FirstTime); err != nil {
+			return nil, err
+		}
+		if item.LastTime, err = ParseLimitTime(row.TradeDate, row.LastTime); err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+
+	return items, nil
+}
+
+// ParseLimitTime 将 HHMMSS 格式的时间（如 "093000"，缺少前导零的 "93000" 同样接受）
+// 解析为交易日 date 当天的北京时间；s 为空时返回零值
+func ParseLimitTime(date tushare.Date, s string) (tushare.DateTime, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return tushare.DateTime{}, nil
+	}
+	if date.IsZero() {
+		return tushare.DateTime{}, fmt.Errorf("parse time %q without trade_date", s)
+	}
+	if len(s) < 6 {
+		s = strings.Repeat("0", 6-len(s)) + s
+	}
+	clock, err := time.Parse("150405", s)
+	if err != nil {
+		return tushare.DateTime{}, fmt.Errorf("invalid time %q", s)
+	}
+	return tushare.DateTime{Time: time.Date(date.Year(), date.Month(), date.Day(),
+		clock.Hour(), clock.Minute(), clock.Second(), 0, tushare.Shanghai)}, nil
+}
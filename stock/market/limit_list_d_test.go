@@ -0,0 +1,59 @@
+package market
+
+import (
+	"strings"
+	"testing"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// limitListDStub 按请求的字段返回一行涨停数据
+type limitListDStub struct {
+	fields string
+}
+
+func (q *limitListDStub) Query(apiName string, params map[string]interface{}, fields string, opts ...tushare.QueryOption) (*tushare.Response, error) {
+	q.fields = fields
+	row := map[string]interface{}{
+		"trade_date": "20240102",
+		"ts_code":    "000001.SZ",
+		"first_time": "93000",
+		"last_time":  "",
+	}
+	data := &tushare.ResponseData{Fields: strings.Split(fields, ",")}
+	item := make([]interface{}, len(data.Fields))
+	for k, f := range data.Fields {
+		item[k] = row[f]
+	}
+	data.Items = [][]interface{}{item}
+	return &tushare.Response{Data: data}, nil
+}
+
+func (q *limitListDStub) QueryOne(apiName string, params map[string]interface{}, fields string, opts ...tushare.QueryOption) (*tushare.Response, error) {
+	return q.Query(apiName, params, fields, opts...)
+}
+
+func TestLimitListD_FieldSubset(t *testing.T) {
+	q := &limitListDStub{}
+	items, err := LimitListD(q, &LimitListDParams{
+		TradeDate: "20240102",
+		Fields:    []string{LimitListDFieldTSCode, LimitListDFieldFirstTime},
+	})
+	if err != nil {
+		t.Fatalf("LimitListD 失败: %v", err)
+	}
+	if q.fields != "ts_code,first_time,trade_date" {
+		t.Errorf("期望自动请求 trade_date，但请求字段为 %s", q.fields)
+	}
+	if len(items) != 1 || items[0].FirstTime.String() != "2024-01-02 09:30:00" || !items[0].LastTime.IsZero() {
+		t.Errorf("封板时间解析不正确: %+v", items)
+	}
+
+	// 不请求封板时间时不追加字段
+	if _, err := LimitListD(q, &LimitListDParams{Fields: []string{LimitListDFieldTSCode}}); err != nil {
+		t.Fatalf("LimitListD 失败: %v", err)
+	}
+	if q.fields != "ts_code" {
+		t.Errorf("期望只请求 ts_code，但请求字段为 %s", q.fields)
+	}
+}
@@ -0,0 +1,92 @@
+package market
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// MonthlyField 返回字段常量
+const (
+	MonthlyFieldTSCode    = "ts_code"    // 股票代码
+	MonthlyFieldTradeDate = "trade_date" // 交易日期
+	MonthlyFieldOpen      = "open"       // 月开盘价
+	MonthlyFieldHigh      = "high"       // 月最高价
+	MonthlyFieldLow       = "low"        // 月最低价
+	MonthlyFieldClose     = "close"      // 月收盘价
+	MonthlyFieldPreClose  = "pre_close"  // 上月收盘价
+	MonthlyFieldChange    = "change"     // 月涨跌额
+	MonthlyFieldPctChg    = "pct_chg"    // 月涨跌幅（未复权）
+	MonthlyFieldVol       = "vol"        // 月成交量
+	MonthlyFieldAmount    = "amount"     // 月成交额
+)
+
+// MonthlyParams A股月线行情参数
+// 接口: monthly
+// 描述: 获取A股月线行情，每月最后一个交易日更新。
+// 调用限制：单次最大4500行，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=145
+type MonthlyParams struct {
+	TSCode    string   // 股票代码（ts_code 和 trade_date 两个参数任选一）
+	TradeDate string   // 交易日期（每月最后一个交易日的日期，YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// MonthlyItem A股月线行情响应项
+type MonthlyItem struct {
+	TSCode    string  `json:"ts_code"`    // 股票代码
+	TradeDate string  `json:"trade_date"` // 交易日期
+	Open      float64 `json:"open"`       // 月开盘价
+	High      float64 `json:"high"`       // 月最高价
+	Low       float64 `json:"low"`        // 月最低价
+	Close     float64 `json:"close"`      // 月收盘价
+	PreClose  float64 `json:"pre_close"`  // 上月收盘价
+	Change    float64 `json:"change"`     // 月涨跌额
+	PctChg    float64 `json:"pct_chg"`    // 月涨跌幅（未复权）
+	Vol       float64 `json:"vol"`        // 月成交量
+	Amount    float64 `json:"amount"`     // 月成交额
+}
+
+// Monthly 获取A股月线行情数据（自动处理分页）
+// 根据指定条件获取股票的月线行情数据
+func Monthly(c tushare.Querier, params *MonthlyParams, opts ...tushare.QueryOption) ([]*MonthlyItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("monthly", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*MonthlyItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,219 @@
+package market
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	tushare "github.com/fletcherlau/go-tushare"
+	"github.com/fletcherlau/go-tushare/stock/basic"
+)
+
+// Period 重采样周期
+type Period string
+
+const (
+	// PeriodWeekly 周线（按自然周）
+	PeriodWeekly Period = "W"
+	// PeriodMonthly 月线
+	PeriodMonthly Period = "M"
+	// PeriodQuarterly 季线
+	PeriodQuarterly Period = "Q"
+)
+
+// Resample 将日线行情按周、月或季度合成 K 线，按 ts_code 分别计算
+//
+// 每根 K 线的 open 为周期内第一个交易日的开盘价，high/low 为最高/最低价，close 为最后一个交易日的收盘价，
+// vol/amount 为合计；pre_close 为上一根 K 线的收盘价（第一根取周期内第一天的 pre_close），
+// 并据此重新计算 change 和 pct_chg。
+//
+// trade_date 为日历中该周期的最后一个交易日（与 Tushare 周线、月线一致，即使该股票当天停牌）；
+// cal 为 nil 或不包含该周期时，取该股票在周期内最后一个有数据的日期。
+// 结果按 ts_code、trade_date 升序排列，输入的顺序不限。
+func Resample(items []*DailyItem, cal []*basic.TradeCalItem, period Period) ([]*DailyItem, error) {
+	var keyOf func(t time.Time) string
+	switch period {
+	case PeriodWeekly:
+		keyOf = func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%04d-W%02d", year, week)
+		}
+	case PeriodMonthly:
+		keyOf = func(t time.Time) string {
+			return t.Format("200601")
+		}
+	case PeriodQuarterly:
+		keyOf = func(t time.Time) string {
+			return fmt.Sprintf("%04d-Q%d", t.Year(), (int(t.Month())+2)/3)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resample period: %q", period)
+	}
+
+	openDays, err := calendarOpenDays(cal)
+	if err != nil {
+		return nil, err
+	}
+	periodEnd := make(map[string]string)
+	for _, day := range openDays {
+		periodEnd[keyOf(day)] = day.Format(tushare.DateLayout)
+	}
+
+	return resampleBy(items, func(date time.Time) (string, string, error) {
+		key := keyOf(date)
+		return key, periodEnd[key], nil
+	})
+}
+
+// ResampleDays 将日线行情按每 n 个交易日合成 K 线，按 ts_code 分别计算
+//
+// 交易日按日历 cal 从第一个交易日开始每 n 个分为一组，因此不同股票的 K 线边界一致，
+// 停牌日同样占用交易日。trade_date 为每组在日历中的最后一个交易日，其余规则与 Resample 相同。
+// 日线中出现日历以外的日期时返回错误。
+func ResampleDays(items []*DailyItem, cal []*basic.TradeCalItem, n int) ([]*DailyItem, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("resample days must be positive, got %d", n)
+	}
+	openDays, err := calendarOpenDays(cal)
+	if err != nil {
+		return nil, err
+	}
+	if len(openDays) == 0 {
+		return nil, fmt.Errorf("resample days requires a trading calendar")
+	}
+
+	session := make(map[string]int, len(openDays))
+	for k, day := range openDays {
+		session[day.Format(tushare.DateLayout)] = k
+	}
+
+	return resampleBy(items, func(date time.Time) (string, string, error) {
+		k, ok := session[date.Format(tushare.DateLayout)]
+		if !ok {
+			return "", "", fmt.Errorf("date %s is not a trading day in calendar", date.Format(tushare.DateLayout))
+		}
+		bucket := k / n
+		last := min((bucket+1)*n, len(openDays)) - 1
+		return fmt.Sprint(bucket), openDays[last].Format(tushare.DateLayout), nil
+	})
+}
+
+// ResampleDataFrame 对日线 DataFrame 执行 Resample，返回列与 DailyItem 相同的 DataFrame
+func ResampleDataFrame(df *tushare.DataFrame, cal []*basic.TradeCalItem, period Period) (*tushare.DataFrame, error) {
+	return resampleDataFrame(df, func(items []*DailyItem) ([]*DailyItem, error) {
+		return Resample(items, cal, period)
+	})
+}
+
+// ResampleDaysDataFrame 对日线 DataFrame 执行 ResampleDays，返回列与 DailyItem 相同的 DataFrame
+func ResampleDaysDataFrame(df *tushare.DataFrame, cal []*basic.TradeCalItem, n int) (*tushare.DataFrame, error) {
+	return resampleDataFrame(df, func(items []*DailyItem) ([]*DailyItem, error) {
+		return ResampleDays(items, cal, n)
+	})
+}
+
+// resampleDataFrame 将日线 DataFrame 转换为 DailyItem 后调用 resample
+func resampleDataFrame(df *tushare.DataFrame, resample func(items []*DailyItem) ([]*DailyItem, error)) (*tushare.DataFrame, error) {
+	var items []*DailyItem
+	if err := df.ToStruct(&items); err != nil {
+		return nil, err
+	}
+	bars, err := resample(items)
+	if err != nil {
+		return nil, err
+	}
+	return tushare.DataFrameFromStructs(bars)
+}
+
+// bucketFunc 返回日期所属分组的键和该分组在日历中的结束日期（未知时为空）
+type bucketFunc func(date time.Time) (key, end string, err error)
+
+// resampleBy 按 ts_code 和分组合成 K 线
+func resampleBy(items []*DailyItem, bucketOf bucketFunc) ([]*DailyItem, error) {
+	sorted := make([]*DailyItem, 0, len(items))
+	for _, item := range items {
+		if item != nil {
+			sorted = append(sorted, item)
+		}
+	}
+	sort.SliceStable(sorted, func(a, b int) bool {
+		if sorted[a].TSCode != sorted[b].TSCode {
+			return sorted[a].TSCode < sorted[b].TSCode
+		}
+		return sorted[a].TradeDate < sorted[b].TradeDate
+	})
+
+	var bars []*DailyItem
+	var bar *DailyItem
+	var barKey string
+	for _, item := range sorted {
+		date, err := time.Parse(tushare.DateLayout, item.TradeDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trade_date %q of %s", item.TradeDate, item.TSCode)
+		}
+		key, end, err := bucketOf(date)
+		if err != nil {
+			return nil, err
+		}
+
+		if bar != nil && bar.TSCode == item.TSCode && key == barKey {
+			bar.High = max(bar.High, item.High)
+			bar.Low = min(bar.Low, item.Low)
+			bar.Close = item.Close
+			bar.Vol += item.Vol
+			bar.Amount += item.Amount
+			if end == "" {
+				bar.TradeDate = item.TradeDate
+			}
+			continue
+		}
+
+		preClose := item.PreClose
+		if bar != nil && bar.TSCode == item.TSCode {
+			preClose = bar.Close
+		}
+		if end == "" {
+			end = item.TradeDate
+		}
+		bar = &DailyItem{
+			TSCode:    item.TSCode,
+			TradeDate: end,
+			Open:      item.Open,
+			High:      item.High,
+			Low:       item.Low,
+			Close:     item.Close,
+			PreClose:  preClose,
+			Vol:       item.Vol,
+			Amount:    item.Amount,
+		}
+		barKey = key
+		bars = append(bars, bar)
+	}
+
+	for _, b := range bars {
+		b.Change = b.Close - b.PreClose
+		if b.PreClose != 0 {
+			b.PctChg = b.Change / b.PreClose * 100
+		}
+	}
+	return bars, nil
+}
+
+// calendarOpenDays 返回日历中去重并升序排列的交易日
+func calendarOpenDays(cal []*basic.TradeCalItem) ([]time.Time, error) {
+	seen := make(map[string]bool, len(cal))
+	days := make([]time.Time, 0, len(cal))
+	for _, item := range cal {
+		if item == nil || item.IsOpen != basic.TradeCalIsOpenYes || seen[item.CalDate] {
+			continue
+		}
+		day, err := time.Parse(tushare.DateLayout, item.CalDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cal_date %q", item.CalDate)
+		}
+		seen[item.CalDate] = true
+		days = append(days, day)
+	}
+	sort.Slice(days, func(a, b int) bool { return days[a].Before(days[b]) })
+	return days, nil
+}
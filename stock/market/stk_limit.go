@@ -0,0 +1,80 @@
+package market
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// StkLimitField 返回字段常量
+const (
+	StkLimitFieldTradeDate = "trade_date" // 交易日期
+	StkLimitFieldTSCode    = "ts_code"    // 股票代码
+	StkLimitFieldPreClose  = "pre_close"  // 昨日收盘价
+	StkLimitFieldUpLimit   = "up_limit"   // 涨停价
+	StkLimitFieldDownLimit = "down_limit" // 跌停价
+)
+
+// StkLimitParams 每日涨跌停价格参数
+// 接口: stk_limit
+// 描述: 获取全市场（包含A/B股和基金）每日涨跌停价格，包括涨停价格，跌停价格等，每个交易日8点40左右更新当日股票涨跌停价格。
+// 调用限制：单次最多提取5800条记录，可循环调取，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=183
+type StkLimitParams struct {
+	TSCode    string   // 股票代码
+	TradeDate string   // 交易日期（YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// StkLimitItem 每日涨跌停价格响应项
+type StkLimitItem struct {
+	TradeDate string  `json:"trade_date"` // 交易日期
+	TSCode    string  `json:"ts_code"`    // 股票代码
+	PreClose  float64 `json:"pre_close"`  // 昨日收盘价
+	UpLimit   float64 `json:"up_limit"`   // 涨停价
+	DownLimit float64 `json:"down_limit"` // 跌停价
+}
+
+// StkLimit 获取每日涨跌停价格（自动处理分页）
+// 根据指定条件获取股票每日的涨停价和跌停价
+func StkLimit(c tushare.Querier, params *StkLimitParams, opts ...tushare.QueryOption) ([]*StkLimitItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("stk_limit", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*StkLimitItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
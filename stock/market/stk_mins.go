@@ -0,0 +1,200 @@
+package market
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// StkMinsFreq 分钟频度
+type StkMinsFreq string
+
+const (
+	// StkMinsFreq1Min 1分钟
+	StkMinsFreq1Min StkMinsFreq = "1min"
+	// StkMinsFreq5Min 5分钟
+	StkMinsFreq5Min StkMinsFreq = "5min"
+	// StkMinsFreq15Min 15分钟
+	StkMinsFreq15Min StkMinsFreq = "15min"
+	// StkMinsFreq30Min 30分钟
+	StkMinsFreq30Min StkMinsFreq = "30min"
+	// StkMinsFreq60Min 60分钟
+	StkMinsFreq60Min StkMinsFreq = "60min"
+)
+
+// minutes 返回频度对应的分钟数
+func (f StkMinsFreq) minutes() (int, error) {
+	switch f {
+	case StkMinsFreq1Min:
+		return 1, nil
+	case StkMinsFreq5Min:
+		return 5, nil
+	case StkMinsFreq15Min:
+		return 15, nil
+	case StkMinsFreq30Min:
+		return 30, nil
+	case StkMinsFreq60Min:
+		return 60, nil
+	}
+	return 0, fmt.Errorf("unsupported stk_mins freq: %q", f)
+}
+
+// StkMinsField 返回字段常量
+const (
+	StkMinsFieldTSCode    = "ts_code"    // 股票代码
+	StkMinsFieldTradeTime = "trade_time" // 交易时间
+	StkMinsFieldOpen      = "open"       // 开盘价
+	StkMinsFieldClose     = "close"      // 收盘价
+	StkMinsFieldHigh      = "high"       // 最高价
+	StkMinsFieldLow       = "low"        // 最低价
+	StkMinsFieldVol       = "vol"        // 成交量（股）
+	StkMinsFieldAmount    = "amount"     // 成交金额（元）
+)
+
+// StkMinsRowLimit stk_mins 单次调用最多返回的行数
+const StkMinsRowLimit = 8000
+
+// StkMinsParams A股分钟行情参数
+// 接口: stk_mins
+// 描述: 获取A股分钟数据，支持1min/5min/15min/30min/60min行情。
+// 调用限制：单次最大8000行数据，StkMins 会按股票和时间分段查询。
+// 文档: https://tushare.pro/document/2?doc_id=370
+type StkMinsParams struct {
+	TSCode    string      // 股票代码（必填，支持多个股票逗号分隔）
+	Freq      StkMinsFreq // 分钟频度（必填）
+	StartDate time.Time   // 开始时间（按北京时间发送，零值表示不限）
+	EndDate   time.Time   // 结束时间（按北京时间发送，零值表示不限）
+	Fields    []string    // 返回字段列表
+}
+
+// MinuteBarItem 分钟行情响应项
+type MinuteBarItem struct {
+	TSCode    string           `json:"ts_code"`    // 股票代码
+	TradeTime tushare.DateTime `json:"trade_time"` // 交易时间（北京时间）
+	Open      float64          `json:"open"`       // 开盘价
+	Close     float64          `json:"close"`      // 收盘价
+	High      float64          `json:"high"`       // 最高价
+	Low       float64          `json:"low"`        // 最低价
+	Vol       float64          `json:"vol"`        // 成交量（股）
+	Amount    float64          `json:"amount"`     // 成交金额（元）
+}
+
+// StkMins 获取A股分钟行情数据（自动处理分页和分段）
+//
+// 按频度估算每次调用不超过 StkMinsRowLimit 行：股票较多时先按股票分批，
+// StartDate 和 EndDate 均不为零值时再将区间切分为多段依次查询，因此可以一次获取较长时间、较多股票的分钟数据。
+// 结果按 ts_code、trade_time 升序排列并去重。
+func StkMins(c tushare.Querier, params *StkMinsParams, opts ...tushare.QueryOption) ([]*MinuteBarItem, error) {
+	minutes, err := params.Freq.minutes()
+	if err != nil {
+		return nil, err
+	}
+	if params.TSCode == "" {
+		return nil, fmt.Errorf("stk_mins requires ts_code")
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		// 排序和去重需要股票代码和交易时间
+		fields = strings.Join(appendMissing(params.Fields, StkMinsFieldTSCode, StkMinsFieldTradeTime), ",")
+	}
+
+	var items []*MinuteBarItem
+	for _, codes := range stkMinsBatches(strings.Split(params.TSCode, ","), minutes) {
+		for _, w := range stkMinsWindows(params.StartDate, params.EndDate, minutes, len(codes)) {
+			reqParams := map[string]interface{}{
+				"ts_code": strings.Join(codes, ","),
+				"freq":    string(params.Freq),
+			}
+			if !w.start.IsZero() {
+				reqParams["start_date"] = tushare.DateTime{Time: w.start}.String()
+			}
+			if !w.end.IsZero() {
+				reqParams["end_date"] = tushare.DateTime{Time: w.end}.String()
+			}
+
+			resp, err := c.Query("stk_mins", reqParams, fields, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if !resp.IsSuccess() {
+				return nil, &tushare.APIError{
+					Code: resp.Code,
+					Msg:  resp.Msg,
+				}
+			}
+
+			var page []*MinuteBarItem
+			if err := resp.ToStruct(&page); err != nil {
+				return nil, err
+			}
+			items = append(items, page...)
+		}
+	}
+
+	sort.SliceStable(items, func(a, b int) bool {
+		if items[a].TSCode != items[b].TSCode {
+			return items[a].TSCode < items[b].TSCode
+		}
+		return items[a].TradeTime.Before(items[b].TradeTime.Time)
+	})
+	result := items[:0]
+	for _, item := range items {
+		if n := len(result); n > 0 && item.TSCode == result[n-1].TSCode && item.TradeTime.Equal(result[n-1].TradeTime.Time) {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// stkMinsBarsPerDay 估算单只股票每个交易日的 K 线数量
+// A股每个交易日交易 240 分钟，按 240/minutes+1 根估算（1 分钟线包含集合竞价的一根）
+func stkMinsBarsPerDay(minutes int) int {
+	return 240/minutes + 1
+}
+
+// stkMinsBatches 将股票按每批一个交易日不超过 StkMinsRowLimit 行分批
+func stkMinsBatches(codes []string, minutes int) [][]string {
+	size := max(StkMinsRowLimit/stkMinsBarsPerDay(minutes), 1)
+	var batches [][]string
+	for len(codes) > size {
+		batches = append(batches, codes[:size])
+		codes = codes[size:]
+	}
+	return append(batches, codes)
+}
+
+// timeWindow 查询的时间段（闭区间）
+type timeWindow struct {
+	start, end time.Time
+}
+
+// stkMinsWindows 将 [start, end] 切分为每段不超过 StkMinsRowLimit 行的时间段
+//
+// 按自然日切分，自然日数不少于交易日数，因此估算偏保守；codes 应已由 stkMinsBatches 分批，
+// 保证一个交易日不超过 StkMinsRowLimit 行。start 或 end 为零值时不切分。
+func stkMinsWindows(start, end time.Time, minutes, codes int) []timeWindow {
+	if start.IsZero() || end.IsZero() || end.Before(start) {
+		return []timeWindow{{start: start, end: end}}
+	}
+
+	barsPerDay := stkMinsBarsPerDay(minutes) * max(codes, 1)
+	days := max(StkMinsRowLimit/barsPerDay, 1)
+
+	var windows []timeWindow
+	for from := start.In(tushare.Shanghai); !from.After(end); {
+		next := from.AddDate(0, 0, days)
+		to := next.Add(-time.Second)
+		if to.After(end) {
+			to = end
+		}
+		windows = append(windows, timeWindow{start: from, end: to})
+		from = next
+	}
+	return windows
+}
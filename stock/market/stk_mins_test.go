@@ -0,0 +1,145 @@
+package market
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+func TestStkMinsWindows(t *testing.T) {
+	at := func(s string) time.Time {
+		d, err := tushare.ParseDateTime(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d.Time
+	}
+	format := func(w timeWindow) string {
+		return tushare.DateTime{Time: w.start}.String() + " ~ " + tushare.DateTime{Time: w.end}.String()
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		minutes    int
+		codes      int
+		want       []string
+	}{
+		{
+			name:    "不限开始时间时不切分",
+			end:     at("2024-03-01 15:00:00"),
+			minutes: 1, codes: 1,
+			want: []string{" ~ 2024-03-01 15:00:00"},
+		},
+		{
+			name:  "结束早于开始时不切分",
+			start: at("2024-03-01 09:00:00"), end: at("2024-01-01 09:00:00"),
+			minutes: 1, codes: 1,
+			want: []string{"2024-03-01 09:00:00 ~ 2024-01-01 09:00:00"},
+		},
+		{
+			name:  "区间不超过一段",
+			start: at("2024-01-02 09:00:00"), end: at("2024-01-05 15:00:00"),
+			minutes: 1, codes: 1,
+			want: []string{"2024-01-02 09:00:00 ~ 2024-01-05 15:00:00"},
+		},
+		{
+			// 1 分钟线每天 241 根，每段 8000/241 = 33 天，最后一段不足 33 天
+			name:  "最后一段不足整段",
+			start: at("2024-01-01 00:00:00"), end: at("2024-03-01 00:00:00"),
+			minutes: 1, codes: 1,
+			want: []string{
+				"2024-01-01 00:00:00 ~ 2024-02-02 23:59:59",
+				"2024-02-03 00:00:00 ~ 2024-03-01 00:00:00",
+			},
+		},
+		{
+			// 恰好两整段时不产生多余的空段
+			name:  "区间恰好为整段",
+			start: at("2024-01-01 00:00:00"), end: at("2024-03-06 23:59:59"),
+			minutes: 1, codes: 1,
+			want: []string{
+				"2024-01-01 00:00:00 ~ 2024-02-02 23:59:59",
+				"2024-02-03 00:00:00 ~ 2024-03-06 23:59:59",
+			},
+		},
+		{
+			// 60 分钟线 10 只股票每天 50 根，每段 160 天
+			name:  "多只股票按股票数缩短每段",
+			start: at("2024-01-01 00:00:00"), end: at("2024-12-31 23:59:59"),
+			minutes: 60, codes: 10,
+			want: []string{
+				"2024-01-01 00:00:00 ~ 2024-06-08 23:59:59",
+				"2024-06-09 00:00:00 ~ 2024-11-15 23:59:59",
+				"2024-11-16 00:00:00 ~ 2024-12-31 23:59:59",
+			},
+		},
+		{
+			// 一天即超过上限时每段至少一天（股票应已由 stkMinsBatches 分批）
+			name:  "每段至少一天",
+			start: at("2024-01-02 09:30:00"), end: at("2024-01-03 15:00:00"),
+			minutes: 1, codes: 40,
+			want: []string{
+				"2024-01-02 09:30:00 ~ 2024-01-03 09:29:59",
+				"2024-01-03 09:30:00 ~ 2024-01-03 15:00:00",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			windows := stkMinsWindows(tt.start, tt.end, tt.minutes, tt.codes)
+			got := make([]string, len(windows))
+			for k, w := range windows {
+				got[k] = format(w)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("期望 %q，但得到 %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStkMinsBatches(t *testing.T) {
+	codes := func(n int) []string {
+		result := make([]string, n)
+		for k := range result {
+			result[k] = fmt.Sprintf("%06d.SZ", k+1)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		codes   int
+		minutes int
+		want    []int // 每批的股票数
+	}{
+		{name: "单只股票", codes: 1, minutes: 1, want: []int{1}},
+		{name: "恰好一批", codes: 33, minutes: 1, want: []int{33}},
+		{name: "超出一只", codes: 34, minutes: 1, want: []int{33, 1}},
+		{name: "多批", codes: 100, minutes: 1, want: []int{33, 33, 33, 1}},
+		{name: "60 分钟线每批 1600 只", codes: 5000, minutes: 60, want: []int{1600, 1600, 1600, 200}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			all := codes(tt.codes)
+			batches := stkMinsBatches(all, tt.minutes)
+			got := make([]int, len(batches))
+			var joined []string
+			for k, batch := range batches {
+				got[k] = len(batch)
+				joined = append(joined, batch...)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("期望每批 %v，但得到 %v", tt.want, got)
+			}
+			if fmt.Sprint(joined) != fmt.Sprint(all) {
+				t.Error("期望分批后保持股票顺序且不重复、不遗漏")
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package market
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// StkWeeklyMonthlyFreq 周月线频率
+type StkWeeklyMonthlyFreq string
+
+const (
+	// StkWeeklyMonthlyFreqWeek 周线
+	StkWeeklyMonthlyFreqWeek StkWeeklyMonthlyFreq = "week"
+	// StkWeeklyMonthlyFreqMonth 月线
+	StkWeeklyMonthlyFreqMonth StkWeeklyMonthlyFreq = "month"
+)
+
+// StkWeeklyMonthlyField 返回字段常量
+const (
+	StkWeeklyMonthlyFieldTSCode    = "ts_code"    // 股票代码
+	StkWeeklyMonthlyFieldTradeDate = "trade_date" // 交易日期
+	StkWeeklyMonthlyFieldEndDate   = "end_date"   // 计算截至日期
+	StkWeeklyMonthlyFieldFreq      = "freq"       // 频率（周 week，月 month）
+	StkWeeklyMonthlyFieldOpen      = "open"       // 开盘价
+	StkWeeklyMonthlyFieldHigh      = "high"       // 最高价
+	StkWeeklyMonthlyFieldLow       = "low"        // 最低价
+	StkWeeklyMonthlyFieldClose     = "close"      // 收盘价
+	StkWeeklyMonthlyFieldPreClose  = "pre_close"  // 上一周期收盘价
+	StkWeeklyMonthlyFieldVol       = "vol"        // 成交量
+	StkWeeklyMonthlyFieldAmount    = "amount"     // 成交额
+	StkWeeklyMonthlyFieldChange    = "change"     // 涨跌额
+	StkWeeklyMonthlyFieldPctChg    = "pct_chg"    // 涨跌幅（未复权）
+)
+
+// StkWeeklyMonthlyParams 股票周/月线行情（每日更新）参数
+// 接口: stk_weekly_monthly
+// 描述: 股票周/月线行情，每日更新，trade_date 为当日时返回截至当日的本周/本月行情。
+// 调用限制：单次最大6000行，可使用交易日期循环提取，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=336
+type StkWeeklyMonthlyParams struct {
+	TSCode    string               // 股票代码
+	TradeDate string               // 交易日期（YYYYMMDD）
+	StartDate string               // 开始日期(YYYYMMDD)
+	EndDate   string               // 结束日期(YYYYMMDD)
+	Freq      StkWeeklyMonthlyFreq // 频率（必填）：week 周线，month 月线
+	Fields    []string             // 返回字段列表
+}
+
+// StkWeeklyMonthlyItem 股票周/月线行情响应项
+type StkWeeklyMonthlyItem struct {
+	TSCode    string               `json:"ts_code"`    // 股票代码
+	TradeDate string               `json:"trade_date"` // 交易日期
+	EndDate   string               `json:"end_date"`   // 计算截至日期
+	Freq      StkWeeklyMonthlyFreq `json:"freq"`       // 频率（周 week，月 month）
+	Open      float64              `json:"open"`       // 开盘价
+	High      float64              `json:"high"`       // 最高价
+	Low       float64              `json:"low"`        // 最低价
+	Close     float64              `json:"close"`      // 收盘价
+	PreClose  float64              `json:"pre_close"`  // 上一周期收盘价
+	Vol       float64              `json:"vol"`        // 成交量
+	Amount    float64              `json:"amount"`     // 成交额
+	Change    float64              `json:"change"`     // 涨跌额
+	PctChg    float64              `json:"pct_chg"`    // 涨跌幅（未复权）
+}
+
+// StkWeeklyMonthly 获取股票周/月线行情数据（自动处理分页）
+// 与 Weekly/Monthly 不同，本接口每日更新，可获取截至当日的本周/本月行情
+func StkWeeklyMonthly(c tushare.Querier, params *StkWeeklyMonthlyParams, opts ...tushare.QueryOption) ([]*StkWeeklyMonthlyItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+	if params.Freq != "" {
+		reqParams["freq"] = string(params.Freq)
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("stk_weekly_monthly", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*StkWeeklyMonthlyItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
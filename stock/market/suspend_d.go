@@ -0,0 +1,92 @@
+package market
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// SuspendType 停复牌类型
+type SuspendType string
+
+const (
+	// SuspendTypeSuspend 停牌
+	SuspendTypeSuspend SuspendType = "S"
+	// SuspendTypeResume 复牌
+	SuspendTypeResume SuspendType = "R"
+)
+
+// SuspendDField 返回字段常量
+const (
+	SuspendDFieldTSCode        = "ts_code"        // 股票代码
+	SuspendDFieldTradeDate     = "trade_date"     // 停复牌日期
+	SuspendDFieldSuspendTiming = "suspend_timing" // 日内停牌时间段
+	SuspendDFieldSuspendType   = "suspend_type"   // 停复牌类型：S-停牌，R-复牌
+)
+
+// SuspendDParams 每日停复牌信息参数
+// 接口: suspend_d
+// 描述: 按日期方式获取股票每日停复牌信息。
+// 调用限制：单次最大5000行。
+// 文档: https://tushare.pro/document/2?doc_id=214
+type SuspendDParams struct {
+	TSCode      string      // 股票代码（可输入多值）
+	TradeDate   string      // 停复牌日期（YYYYMMDD）
+	StartDate   string      // 开始日期(YYYYMMDD)
+	EndDate     string      // 结束日期(YYYYMMDD)
+	SuspendType SuspendType // 停复牌类型：S-停牌，R-复牌
+	Fields      []string    // 返回字段列表
+}
+
+// SuspendDItem 每日停复牌信息响应项
+type SuspendDItem struct {
+	TSCode        string      `json:"ts_code"`        // 股票代码
+	TradeDate     string      `json:"trade_date"`     // 停复牌日期
+	SuspendTiming string      `json:"suspend_timing"` // 日内停牌时间段（为空表示全天停牌）
+	SuspendType   SuspendType `json:"suspend_type"`   // 停复牌类型：S-停牌，R-复牌
+}
+
+// SuspendD 获取每日停复牌信息（自动处理分页）
+// 根据指定条件获取股票的停牌、复牌记录
+func SuspendD(c tushare.Querier, params *SuspendDParams, opts ...tushare.QueryOption) ([]*SuspendDItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+	if params.SuspendType != "" {
+		reqParams["suspend_type"] = string(params.SuspendType)
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("suspend_d", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*SuspendDItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,133 @@
+package market
+
+import (
+	"sort"
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// limitTolerance 判断价格是否触及涨跌停价时的容差（价格最小变动单位为 0.01）
+const limitTolerance = 0.0001
+
+// TradabilityParams 每日可交易状态参数
+type TradabilityParams struct {
+	TSCodes   []string // 股票代码列表，为空时查询全市场
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+}
+
+// TradabilityItem 股票在某个交易日的可交易状态
+type TradabilityItem struct {
+	TSCode        string  // 股票代码
+	TradeDate     string  // 交易日期
+	PreClose      float64 // 昨日收盘价
+	UpLimit       float64 // 涨停价（为 0 表示无涨跌停数据）
+	DownLimit     float64 // 跌停价（为 0 表示无涨跌停数据）
+	Suspended     bool    // 是否全天停牌
+	SuspendTiming string  // 日内停牌时间段（部分时段停牌时不为空）
+}
+
+// CanBuy 判断能否以 price 买入：全天停牌或价格达到涨停价时不能买入
+func (t *TradabilityItem) CanBuy(price float64) bool {
+	if t.Suspended {
+		return false
+	}
+	return t.UpLimit == 0 || price < t.UpLimit-limitTolerance
+}
+
+// CanSell 判断能否以 price 卖出：全天停牌或价格达到跌停价时不能卖出
+func (t *TradabilityItem) CanSell(price float64) bool {
+	if t.Suspended {
+		return false
+	}
+	return t.DownLimit == 0 || price > t.DownLimit+limitTolerance
+}
+
+// Tradability 获取股票在日期范围内每个交易日的可交易状态
+//
+// 按股票分别查询 stk_limit，并查询 suspend_d 的停牌记录，结果由 CombineTradability 合并。
+func Tradability(c tushare.Querier, params *TradabilityParams, opts ...tushare.QueryOption) ([]*TradabilityItem, error) {
+	var limits []*StkLimitItem
+	if len(params.TSCodes) == 0 {
+		items, err := StkLimit(c, &StkLimitParams{
+			StartDate: params.StartDate,
+			EndDate:   params.EndDate,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		limits = items
+	}
+	for _, code := range params.TSCodes {
+		items, err := StkLimit(c, &StkLimitParams{
+			TSCode:    code,
+			StartDate: params.StartDate,
+			EndDate:   params.EndDate,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, items...)
+	}
+
+	suspends, err := SuspendD(c, &SuspendDParams{
+		TSCode:      strings.Join(params.TSCodes, ","),
+		StartDate:   params.StartDate,
+		EndDate:     params.EndDate,
+		SuspendType: SuspendTypeSuspend,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return CombineTradability(limits, suspends), nil
+}
+
+// CombineTradability 按 ts_code、trade_date 合并涨跌停价格和停复牌信息
+//
+// 停牌记录的 suspend_timing 为空时视为全天停牌，否则记录日内停牌时间段；复牌记录不影响可交易状态。
+// 只有停牌记录而没有涨跌停价格的日期同样包含在结果中。结果按 ts_code、trade_date 升序排列。
+func CombineTradability(limits []*StkLimitItem, suspends []*SuspendDItem) []*TradabilityItem {
+	byKey := make(map[[2]string]*TradabilityItem)
+	var result []*TradabilityItem
+	itemOf := func(code, date string) *TradabilityItem {
+		key := [2]string{code, date}
+		item, ok := byKey[key]
+		if !ok {
+			item = &TradabilityItem{TSCode: code, TradeDate: date}
+			byKey[key] = item
+			result = append(result, item)
+		}
+		return item
+	}
+
+	for _, l := range limits {
+		if l == nil {
+			continue
+		}
+		item := itemOf(l.TSCode, l.TradeDate)
+		item.PreClose = l.PreClose
+		item.UpLimit = l.UpLimit
+		item.DownLimit = l.DownLimit
+	}
+	for _, s := range suspends {
+		if s == nil || s.SuspendType != SuspendTypeSuspend {
+			continue
+		}
+		item := itemOf(s.TSCode, s.TradeDate)
+		if s.SuspendTiming == "" {
+			item.Suspended = true
+		} else {
+			item.SuspendTiming = s.SuspendTiming
+		}
+	}
+
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].TSCode != result[b].TSCode {
+			return result[a].TSCode < result[b].TSCode
+		}
+		return result[a].TradeDate < result[b].TradeDate
+	})
+	return result
+}
@@ -0,0 +1,92 @@
+package market
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// WeeklyField 返回字段常量
+const (
+	WeeklyFieldTSCode    = "ts_code"    // 股票代码
+	WeeklyFieldTradeDate = "trade_date" // 交易日期
+	WeeklyFieldOpen      = "open"       // 周开盘价
+	WeeklyFieldHigh      = "high"       // 周最高价
+	WeeklyFieldLow       = "low"        // 周最低价
+	WeeklyFieldClose     = "close"      // 周收盘价
+	WeeklyFieldPreClose  = "pre_close"  // 上周收盘价
+	WeeklyFieldChange    = "change"     // 周涨跌额
+	WeeklyFieldPctChg    = "pct_chg"    // 周涨跌幅（未复权）
+	WeeklyFieldVol       = "vol"        // 周成交量
+	WeeklyFieldAmount    = "amount"     // 周成交额
+)
+
+// WeeklyParams A股周线行情参数
+// 接口: weekly
+// 描述: 获取A股周线行情，每周最后一个交易日更新。
+// 调用限制：单次最大4500行，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=144
+type WeeklyParams struct {
+	TSCode    string   // 股票代码（ts_code 和 trade_date 两个参数任选一）
+	TradeDate string   // 交易日期（每周最后一个交易日的日期，YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// WeeklyItem A股周线行情响应项
+type WeeklyItem struct {
+	TSCode    string  `json:"ts_code"`    // 股票代码
+	TradeDate string  `json:"trade_date"` // 交易日期
+	Open      float64 `json:"open"`       // 周开盘价
+	High      float64 `json:"high"`       // 周最高价
+	Low       float64 `json:"low"`        // 周最低价
+	Close     float64 `json:"close"`      // 周收盘价
+	PreClose  float64 `json:"pre_close"`  // 上周收盘价
+	Change    float64 `json:"change"`     // 周涨跌额
+	PctChg    float64 `json:"pct_chg"`    // 周涨跌幅（未复权）
+	Vol       float64 `json:"vol"`        // 周成交量
+	Amount    float64 `json:"amount"`     // 周成交额
+}
+
+// Weekly 获取A股周线行情数据（自动处理分页）
+// 根据指定条件获取股票的周线行情数据
+func Weekly(c tushare.Querier, params *WeeklyParams, opts ...tushare.QueryOption) ([]*WeeklyItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("weekly", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*WeeklyItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,36 @@
+// Package moneyflow 提供 Tushare 资金流向数据接口
+//
+// 本包目前包含以下接口：
+//   - moneyflow: 个股资金流向（大单、中单、小单）
+//   - moneyflow_hsgt: 沪深港通资金流向
+//   - hsgt_top10: 沪深股通十大成交股
+//   - ggt_top10: 港股通十大成交股
+//
+// 文档参考:
+//   - moneyflow: https://tushare.pro/document/2?doc_id=170
+//   - moneyflow_hsgt: https://tushare.pro/document/2?doc_id=47
+//   - hsgt_top10: https://tushare.pro/document/2?doc_id=48
+//   - ggt_top10: https://tushare.pro/document/2?doc_id=49
+//
+// 使用示例：
+//
+//	import (
+//	    tushare "github.com/fletcherlau/go-tushare"
+//	    "github.com/fletcherlau/go-tushare/stock/moneyflow"
+//	)
+//
+//	func main() {
+//	    client := tushare.NewClient("your_token")
+//
+//	    // 获取个股资金流向
+//	    items, err := moneyflow.Moneyflow(client, &moneyflow.MoneyflowParams{
+//	        TSCode: "000001.SZ",
+//	        Fields: []string{moneyflow.MoneyflowFieldTradeDate, moneyflow.MoneyflowFieldNetMfAmount},
+//	    })
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//
+//	    fmt.Printf("获取 %d 条记录\n", len(items))
+//	}
+package moneyflow
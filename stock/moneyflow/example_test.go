@@ -0,0 +1,91 @@
+package moneyflow_test
+
+import (
+	"fmt"
+	"log"
+
+	tushare "github.com/fletcherlau/go-tushare"
+	"github.com/fletcherlau/go-tushare/stock/moneyflow"
+)
+
+func ExampleMoneyflow() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取平安银行2024年1月的资金流向
+	items, err := moneyflow.Moneyflow(client, &moneyflow.MoneyflowParams{
+		TSCode:    "000001.SZ",
+		StartDate: "20240101",
+		EndDate:   "20240131",
+		Fields: []string{
+			moneyflow.MoneyflowFieldTradeDate,
+			moneyflow.MoneyflowFieldBuyElgAmount,
+			moneyflow.MoneyflowFieldSellElgAmount,
+			moneyflow.MoneyflowFieldNetMfAmount,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("获取 %d 条记录\n", len(items))
+	if len(items) > 0 {
+		fmt.Printf("第一条: 日期=%s 净流入额=%.2f万元\n",
+			items[0].TradeDate, items[0].NetMfAmount)
+	}
+}
+
+func ExampleMoneyflowHSGT() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月的沪深港通资金流向
+	items, err := moneyflow.MoneyflowHSGT(client, &moneyflow.MoneyflowHSGTParams{
+		StartDate: "20240101",
+		EndDate:   "20240131",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s 北向资金=%.2f百万元 南向资金=%.2f百万元\n",
+			item.TradeDate, item.NorthMoney, item.SouthMoney)
+	}
+}
+
+func ExampleHSGTTop10() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月2日沪股通十大成交股
+	items, err := moneyflow.HSGTTop10(client, &moneyflow.HSGTTop10Params{
+		TradeDate:  "20240102",
+		MarketType: moneyflow.MarketTypeSH,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%d %s %s 净成交=%.0f元\n", item.Rank, item.TSCode, item.Name, item.NetAmount)
+	}
+}
+
+func ExampleGGTTop10() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月2日港股通（深）十大成交股
+	items, err := moneyflow.GGTTop10(client, &moneyflow.GGTTop10Params{
+		TradeDate:  "20240102",
+		MarketType: moneyflow.MarketTypeGGTSZ,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%d %s %s 净买入=%.0f元\n", item.Rank, item.TSCode, item.Name, item.NetAmount)
+	}
+}
@@ -0,0 +1,107 @@
+package moneyflow
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// GGTTop10Field 返回字段常量
+const (
+	GGTTop10FieldTradeDate   = "trade_date"    // 交易日期
+	GGTTop10FieldTSCode      = "ts_code"       // 股票代码
+	GGTTop10FieldName        = "name"          // 股票名称
+	GGTTop10FieldClose       = "close"         // 收盘价
+	GGTTop10FieldPChange     = "p_change"      // 涨跌幅
+	GGTTop10FieldRank        = "rank"          // 资金排名
+	GGTTop10FieldMarketType  = "market_type"   // 市场类型：2 港股通（沪），4 港股通（深）
+	GGTTop10FieldAmount      = "amount"        // 累计成交金额（元）
+	GGTTop10FieldNetAmount   = "net_amount"    // 净买入金额（元）
+	GGTTop10FieldSHAmount    = "sh_amount"     // 沪市成交金额（元）
+	GGTTop10FieldSHNetAmount = "sh_net_amount" // 沪市净买入金额（元）
+	GGTTop10FieldSHBuy       = "sh_buy"        // 沪市买入金额（元）
+	GGTTop10FieldSHSell      = "sh_sell"       // 沪市卖出金额（元）
+	GGTTop10FieldSZAmount    = "sz_amount"     // 深市成交金额（元）
+	GGTTop10FieldSZNetAmount = "sz_net_amount" // 深市净买入金额（元）
+	GGTTop10FieldSZBuy       = "sz_buy"        // 深市买入金额（元）
+	GGTTop10FieldSZSell      = "sz_sell"       // 深市卖出金额（元）
+)
+
+// GGTTop10Params 港股通十大成交股参数
+// 接口: ggt_top10
+// 描述: 获取港股通每日成交数据，其中包括沪市、深市详细数据。
+// 文档: https://tushare.pro/document/2?doc_id=49
+type GGTTop10Params struct {
+	TSCode     string     // 股票代码
+	TradeDate  string     // 交易日期（YYYYMMDD）
+	StartDate  string     // 开始日期(YYYYMMDD)
+	EndDate    string     // 结束日期(YYYYMMDD)
+	MarketType MarketType // 市场类型：2 港股通（沪），4 港股通（深）
+	Fields     []string   // 返回字段列表
+}
+
+// GGTTop10Item 港股通十大成交股响应项
+type GGTTop10Item struct {
+	TradeDate   string     `json:"trade_date"`    // 交易日期
+	TSCode      string     `json:"ts_code"`       // 股票代码
+	Name        string     `json:"name"`          // 股票名称
+	Close       float64    `json:"close"`         // 收盘价
+	PChange     float64    `json:"p_change"`      // 涨跌幅
+	Rank        int        `json:"rank"`          // 资金排名
+	MarketType  MarketType `json:"market_type"`   // 市场类型：2 港股通（沪），4 港股通（深）
+	Amount      float64    `json:"amount"`        // 累计成交金额（元）
+	NetAmount   float64    `json:"net_amount"`    // 净买入金额（元）
+	SHAmount    float64    `json:"sh_amount"`     // 沪市成交金额（元）
+	SHNetAmount float64    `json:"sh_net_amount"` // 沪市净买入金额（元）
+	SHBuy       float64    `json:"sh_buy"`        // 沪市买入金额（元）
+	SHSell      float64    `json:"sh_sell"`       // 沪市卖出金额（元）
+	SZAmount    float64    `json:"sz_amount"`     // 深市成交金额（元）
+	SZNetAmount float64    `json:"sz_net_amount"` // 深市净买入金额（元）
+	SZBuy       float64    `json:"sz_buy"`        // 深市买入金额（元）
+	SZSell      float64    `json:"sz_sell"`       // 深市卖出金额（元）
+}
+
+// GGTTop10 获取港股通十大成交股数据（自动处理分页）
+// 根据指定条件获取南向资金每日前十大成交股票
+func GGTTop10(c tushare.Querier, params *GGTTop10Params, opts ...tushare.QueryOption) ([]*GGTTop10Item, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+	if params.MarketType != "" {
+		reqParams["market_type"] = string(params.MarketType)
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("ggt_top10", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*GGTTop10Item
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
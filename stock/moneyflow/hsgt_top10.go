@@ -0,0 +1,109 @@
+package moneyflow
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// MarketType 沪深港通市场类型
+type MarketType string
+
+const (
+	// MarketTypeSH 沪市（沪股通）
+	MarketTypeSH MarketType = "1"
+	// MarketTypeGGTSH 港股通（沪）
+	MarketTypeGGTSH MarketType = "2"
+	// MarketTypeSZ 深市（深股通）
+	MarketTypeSZ MarketType = "3"
+	// MarketTypeGGTSZ 港股通（深）
+	MarketTypeGGTSZ MarketType = "4"
+)
+
+// HSGTTop10Field 返回字段常量
+const (
+	HSGTTop10FieldTradeDate  = "trade_date"  // 交易日期
+	HSGTTop10FieldTSCode     = "ts_code"     // 股票代码
+	HSGTTop10FieldName       = "name"        // 股票名称
+	HSGTTop10FieldClose      = "close"       // 收盘价
+	HSGTTop10FieldChange     = "change"      // 涨跌额
+	HSGTTop10FieldRank       = "rank"        // 资金排名
+	HSGTTop10FieldMarketType = "market_type" // 市场类型：1 沪市，3 深市
+	HSGTTop10FieldAmount     = "amount"      // 成交金额（元）
+	HSGTTop10FieldNetAmount  = "net_amount"  // 净成交金额（元）
+	HSGTTop10FieldBuy        = "buy"         // 买入金额（元）
+	HSGTTop10FieldSell       = "sell"        // 卖出金额（元）
+)
+
+// HSGTTop10Params 沪深股通十大成交股参数
+// 接口: hsgt_top10
+// 描述: 获取沪股通、深股通每日前十大成交详细数据。
+// 文档: https://tushare.pro/document/2?doc_id=48
+type HSGTTop10Params struct {
+	TSCode     string     // 股票代码
+	TradeDate  string     // 交易日期（YYYYMMDD）
+	StartDate  string     // 开始日期(YYYYMMDD)
+	EndDate    string     // 结束日期(YYYYMMDD)
+	MarketType MarketType // 市场类型：1 沪市，3 深市
+	Fields     []string   // 返回字段列表
+}
+
+// HSGTTop10Item 沪深股通十大成交股响应项
+type HSGTTop10Item struct {
+	TradeDate  string     `json:"trade_date"`  // 交易日期
+	TSCode     string     `json:"ts_code"`     // 股票代码
+	Name       string     `json:"name"`        // 股票名称
+	Close      float64    `json:"close"`       // 收盘价
+	Change     float64    `json:"change"`      // 涨跌额
+	Rank       int        `json:"rank"`        // 资金排名
+	MarketType MarketType `json:"market_type"` // 市场类型：1 沪市，3 深市
+	Amount     float64    `json:"amount"`      // 成交金额（元）
+	NetAmount  float64    `json:"net_amount"`  // 净成交金额（元）
+	Buy        float64    `json:"buy"`         // 买入金额（元）
+	Sell       float64    `json:"sell"`        // 卖出金额（元）
+}
+
+// HSGTTop10 获取沪深股通十大成交股数据（自动处理分页）
+// 根据指定条件获取北向资金每日前十大成交股票
+func HSGTTop10(c tushare.Querier, params *HSGTTop10Params, opts ...tushare.QueryOption) ([]*HSGTTop10Item, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+	if params.MarketType != "" {
+		reqParams["market_type"] = string(params.MarketType)
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("hsgt_top10", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*HSGTTop10Item
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,113 @@
+// Package moneyflow 提供 Tushare 资金流向相关接口
+// 文档参考: https://tushare.pro/document/2?doc_id=170
+package moneyflow
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// MoneyflowField 返回字段常量
+const (
+	MoneyflowFieldTSCode        = "ts_code"         // 股票代码
+	MoneyflowFieldTradeDate     = "trade_date"      // 交易日期
+	MoneyflowFieldBuySmVol      = "buy_sm_vol"      // 小单买入量（手）
+	MoneyflowFieldBuySmAmount   = "buy_sm_amount"   // 小单买入金额（万元）
+	MoneyflowFieldSellSmVol     = "sell_sm_vol"     // 小单卖出量（手）
+	MoneyflowFieldSellSmAmount  = "sell_sm_amount"  // 小单卖出金额（万元）
+	MoneyflowFieldBuyMdVol      = "buy_md_vol"      // 中单买入量（手）
+	MoneyflowFieldBuyMdAmount   = "buy_md_amount"   // 中单买入金额（万元）
+	MoneyflowFieldSellMdVol     = "sell_md_vol"     // 中单卖出量（手）
+	MoneyflowFieldSellMdAmount  = "sell_md_amount"  // 中单卖出金额（万元）
+	MoneyflowFieldBuyLgVol      = "buy_lg_vol"      // 大单买入量（手）
+	MoneyflowFieldBuyLgAmount   = "buy_lg_amount"   // 大单买入金额（万元）
+	MoneyflowFieldSellLgVol     = "sell_lg_vol"     // 大单卖出量（手）
+	MoneyflowFieldSellLgAmount  = "sell_lg_amount"  // 大单卖出金额（万元）
+	MoneyflowFieldBuyElgVol     = "buy_elg_vol"     // 特大单买入量（手）
+	MoneyflowFieldBuyElgAmount  = "buy_elg_amount"  // 特大单买入金额（万元）
+	MoneyflowFieldSellElgVol    = "sell_elg_vol"    // 特大单卖出量（手）
+	MoneyflowFieldSellElgAmount = "sell_elg_amount" // 特大单卖出金额（万元）
+	MoneyflowFieldNetMfVol      = "net_mf_vol"      // 净流入量（手）
+	MoneyflowFieldNetMfAmount   = "net_mf_amount"   // 净流入额（万元）
+)
+
+// MoneyflowParams 个股资金流向参数
+// 接口: moneyflow
+// 描述: 获取沪深A股票资金流向数据，分析大单小单成交情况，用于判别资金动向。
+// 小单：5万以下；中单：5万～20万；大单：20万～100万；特大单：成交额>=100万。
+// 调用限制：单次最大提取6000行记录，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=170
+type MoneyflowParams struct {
+	TSCode    string   // 股票代码（股票和时间参数至少输入一个）
+	TradeDate string   // 交易日期（YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// MoneyflowItem 个股资金流向响应项
+type MoneyflowItem struct {
+	TSCode        string  `json:"ts_code"`         // 股票代码
+	TradeDate     string  `json:"trade_date"`      // 交易日期
+	BuySmVol      float64 `json:"buy_sm_vol"`      // 小单买入量（手）
+	BuySmAmount   float64 `json:"buy_sm_amount"`   // 小单买入金额（万元）
+	SellSmVol     float64 `json:"sell_sm_vol"`     // 小单卖出量（手）
+	SellSmAmount  float64 `json:"sell_sm_amount"`  // 小单卖出金额（万元）
+	BuyMdVol      float64 `json:"buy_md_vol"`      // 中单买入量（手）
+	BuyMdAmount   float64 `json:"buy_md_amount"`   // 中单买入金额（万元）
+	SellMdVol     float64 `json:"sell_md_vol"`     // 中单卖出量（手）
+	SellMdAmount  float64 `json:"sell_md_amount"`  // 中单卖出金额（万元）
+	BuyLgVol      float64 `json:"buy_lg_vol"`      // 大单买入量（手）
+	BuyLgAmount   float64 `json:"buy_lg_amount"`   // 大单买入金额（万元）
+	SellLgVol     float64 `json:"sell_lg_vol"`     // 大单卖出量（手）
+	SellLgAmount  float64 `json:"sell_lg_amount"`  // 大单卖出金额（万元）
+	BuyElgVol     float64 `json:"buy_elg_vol"`     // 特大单买入量（手）
+	BuyElgAmount  float64 `json:"buy_elg_amount"`  // 特大单买入金额（万元）
+	SellElgVol    float64 `json:"sell_elg_vol"`    // 特大单卖出量（手）
+	SellElgAmount float64 `json:"sell_elg_amount"` // 特大单卖出金额（万元）
+	NetMfVol      float64 `json:"net_mf_vol"`      // 净流入量（手）
+	NetMfAmount   float64 `json:"net_mf_amount"`   // 净流入额（万元）
+}
+
+// Moneyflow 获取个股资金流向数据（自动处理分页）
+// 根据指定条件获取股票每日大、中、小单的买卖情况
+func Moneyflow(c tushare.Querier, params *MoneyflowParams, opts ...tushare.QueryOption) ([]*MoneyflowItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("moneyflow", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*MoneyflowItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
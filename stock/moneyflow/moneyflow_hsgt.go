@@ -0,0 +1,79 @@
+package moneyflow
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// MoneyflowHSGTField 返回字段常量
+const (
+	MoneyflowHSGTFieldTradeDate  = "trade_date"  // 交易日期
+	MoneyflowHSGTFieldGGTSS      = "ggt_ss"      // 港股通（上海）（百万元）
+	MoneyflowHSGTFieldGGTSZ      = "ggt_sz"      // 港股通（深圳）（百万元）
+	MoneyflowHSGTFieldHGT        = "hgt"         // 沪股通（百万元）
+	MoneyflowHSGTFieldSGT        = "sgt"         // 深股通（百万元）
+	MoneyflowHSGTFieldNorthMoney = "north_money" // 北向资金（百万元）
+	MoneyflowHSGTFieldSouthMoney = "south_money" // 南向资金（百万元）
+)
+
+// MoneyflowHSGTParams 沪深港通资金流向参数
+// 接口: moneyflow_hsgt
+// 描述: 获取沪股通、深股通、港股通每日资金流向数据，每次最多返回300条记录，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=47
+type MoneyflowHSGTParams struct {
+	TradeDate string   // 交易日期（YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// MoneyflowHSGTItem 沪深港通资金流向响应项
+type MoneyflowHSGTItem struct {
+	TradeDate  string  `json:"trade_date"`  // 交易日期
+	GGTSS      float64 `json:"ggt_ss"`      // 港股通（上海）（百万元）
+	GGTSZ      float64 `json:"ggt_sz"`      // 港股通（深圳）（百万元）
+	HGT        float64 `json:"hgt"`         // 沪股通（百万元）
+	SGT        float64 `json:"sgt"`         // 深股通（百万元）
+	NorthMoney float64 `json:"north_money"` // 北向资金（百万元）
+	SouthMoney float64 `json:"south_money"` // 南向资金（百万元）
+}
+
+// MoneyflowHSGT 获取沪深港通资金流向数据（自动处理分页）
+// 根据指定日期获取北向、南向资金每日流向
+func MoneyflowHSGT(c tushare.Querier, params *MoneyflowHSGTParams, opts ...tushare.QueryOption) ([]*MoneyflowHSGTItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("moneyflow_hsgt", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*MoneyflowHSGTItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,83 @@
+package specialtrading
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// BlockTradeField 返回字段常量
+const (
+	BlockTradeFieldTSCode    = "ts_code"    // 股票代码
+	BlockTradeFieldTradeDate = "trade_date" // 交易日期
+	BlockTradeFieldPrice     = "price"      // 成交价
+	BlockTradeFieldVol       = "vol"        // 成交量（万股）
+	BlockTradeFieldAmount    = "amount"     // 成交金额（万元）
+	BlockTradeFieldBuyer     = "buyer"      // 买方营业部
+	BlockTradeFieldSeller    = "seller"     // 卖方营业部
+)
+
+// BlockTradeParams 大宗交易参数
+// 接口: block_trade
+// 描述: 获取大宗交易数据，单次最大1000条，总量不限制。
+// 文档: https://tushare.pro/document/2?doc_id=161
+type BlockTradeParams struct {
+	TSCode    string   // 股票代码
+	TradeDate string   // 交易日期（YYYYMMDD）
+	StartDate string   // 开始日期(YYYYMMDD)
+	EndDate   string   // 结束日期(YYYYMMDD)
+	Fields    []string // 返回字段列表
+}
+
+// BlockTradeItem 大宗交易响应项
+type BlockTradeItem struct {
+	TSCode    string  `json:"ts_code"`    // 股票代码
+	TradeDate string  `json:"trade_date"` // 交易日期
+	Price     float64 `json:"price"`      // 成交价
+	Vol       float64 `json:"vol"`        // 成交量（万股）
+	Amount    float64 `json:"amount"`     // 成交金额（万元）
+	Buyer     string  `json:"buyer"`      // 买方营业部
+	Seller    string  `json:"seller"`     // 卖方营业部
+}
+
+// BlockTrade 获取大宗交易数据（自动处理分页）
+// 根据指定条件获取股票的大宗交易成交价、成交量和买卖双方营业部
+func BlockTrade(c tushare.Querier, params *BlockTradeParams, opts ...tushare.QueryOption) ([]*BlockTradeItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.StartDate != "" {
+		reqParams["start_date"] = params.StartDate
+	}
+	if params.EndDate != "" {
+		reqParams["end_date"] = params.EndDate
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("block_trade", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*BlockTradeItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,36 @@
+// Package specialtrading 提供 Tushare 特色交易数据接口（目录 stock/special-trading）
+//
+// 本包目前包含以下接口：
+//   - top_list: 龙虎榜每日明细
+//   - top_inst: 龙虎榜机构明细
+//   - block_trade: 大宗交易
+//
+// 以及以下工具：
+//   - GroupTopInst: 将龙虎榜机构明细按交易日期和股票分组为买入、卖出席位
+//
+// 文档参考:
+//   - top_list: https://tushare.pro/document/2?doc_id=106
+//   - top_inst: https://tushare.pro/document/2?doc_id=107
+//   - block_trade: https://tushare.pro/document/2?doc_id=161
+//
+// 使用示例：
+//
+//	import (
+//	    tushare "github.com/fletcherlau/go-tushare"
+//	    specialtrading "github.com/fletcherlau/go-tushare/stock/special-trading"
+//	)
+//
+//	func main() {
+//	    client := tushare.NewClient("your_token")
+//
+//	    // 获取龙虎榜每日明细
+//	    items, err := specialtrading.TopList(client, &specialtrading.TopListParams{
+//	        TradeDate: "20240102",
+//	    })
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//
+//	    fmt.Printf("获取 %d 条记录\n", len(items))
+//	}
+package specialtrading
@@ -0,0 +1,91 @@
+package specialtrading_test
+
+import (
+	"fmt"
+	"log"
+
+	tushare "github.com/fletcherlau/go-tushare"
+	specialtrading "github.com/fletcherlau/go-tushare/stock/special-trading"
+)
+
+func ExampleTopList() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月2日的龙虎榜
+	items, err := specialtrading.TopList(client, &specialtrading.TopListParams{
+		TradeDate: "20240102",
+		Fields: []string{
+			specialtrading.TopListFieldTSCode,
+			specialtrading.TopListFieldName,
+			specialtrading.TopListFieldNetAmount,
+			specialtrading.TopListFieldReason,
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s %s 净买入=%.0f元 %s\n", item.TSCode, item.Name, item.NetAmount, item.Reason)
+	}
+}
+
+func ExampleTopInst() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月2日的龙虎榜机构明细，并按股票列出买卖席位
+	items, err := specialtrading.TopInst(client, &specialtrading.TopInstParams{
+		TradeDate: "20240102",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, group := range specialtrading.GroupTopInst(items) {
+		fmt.Printf("%s 买入席位 %d 个，卖出席位 %d 个\n", group.TSCode, len(group.Buyers), len(group.Sellers))
+	}
+}
+
+func ExampleGroupTopInst() {
+	items := []*specialtrading.TopInstItem{
+		{TradeDate: "20240102", TSCode: "600000.SH", Exalter: "营业部A", Side: specialtrading.TopInstSideSell, Sell: 300, NetBuy: -300},
+		{TradeDate: "20240102", TSCode: "600000.SH", Exalter: "营业部B", Side: specialtrading.TopInstSideBuy, Buy: 500, NetBuy: 500},
+		{TradeDate: "20240102", TSCode: "600000.SH", Exalter: "营业部C", Side: specialtrading.TopInstSideBuy, Buy: 800, NetBuy: 800},
+	}
+
+	for _, group := range specialtrading.GroupTopInst(items) {
+		fmt.Println(group.TradeDate, group.TSCode, group.NetBuy())
+		for _, seat := range group.Buyers {
+			fmt.Println("买入", seat.Exalter, seat.Buy)
+		}
+		for _, seat := range group.Sellers {
+			fmt.Println("卖出", seat.Exalter, seat.Sell)
+		}
+	}
+	// Output:
+	// 20240102 600000.SH 1000
+	// 买入 营业部C 800
+	// 买入 营业部B 500
+	// 卖出 营业部A 300
+}
+
+func ExampleBlockTrade() {
+	// 创建客户端
+	client := tushare.NewClient("your_token")
+
+	// 获取2024年1月的大宗交易
+	items, err := specialtrading.BlockTrade(client, &specialtrading.BlockTradeParams{
+		StartDate: "20240101",
+		EndDate:   "20240131",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, item := range items {
+		fmt.Printf("%s %s 成交价=%.2f 买方=%s 卖方=%s\n",
+			item.TradeDate, item.TSCode, item.Price, item.Buyer, item.Seller)
+	}
+}
@@ -0,0 +1,91 @@
+package specialtrading
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// TopInstSide 龙虎榜机构买卖类型
+type TopInstSide string
+
+const (
+	// TopInstSideBuy 买入金额最大的前5名
+	TopInstSideBuy TopInstSide = "0"
+	// TopInstSideSell 卖出金额最大的前5名
+	TopInstSideSell TopInstSide = "1"
+)
+
+// TopInstField 返回字段常量
+const (
+	TopInstFieldTradeDate = "trade_date" // 交易日期
+	TopInstFieldTSCode    = "ts_code"    // 股票代码
+	TopInstFieldExalter   = "exalter"    // 营业部名称
+	TopInstFieldSide      = "side"       // 买卖类型：0 买入金额最大的前5名，1 卖出金额最大的前5名
+	TopInstFieldBuy       = "buy"        // 买入额（元）
+	TopInstFieldBuyRate   = "buy_rate"   // 买入占总成交比例
+	TopInstFieldSell      = "sell"       // 卖出额（元）
+	TopInstFieldSellRate  = "sell_rate"  // 卖出占总成交比例
+	TopInstFieldNetBuy    = "net_buy"    // 净成交额（元）
+	TopInstFieldReason    = "reason"     // 上榜理由
+)
+
+// TopInstParams 龙虎榜机构明细参数
+// 接口: top_inst
+// 描述: 龙虎榜机构成交明细，单次请求最大返回10000行数据，可根据交易日期循环获取。
+// 文档: https://tushare.pro/document/2?doc_id=107
+type TopInstParams struct {
+	TradeDate string   // 交易日期（必填，YYYYMMDD）
+	TSCode    string   // 股票代码
+	Fields    []string // 返回字段列表
+}
+
+// TopInstItem 龙虎榜机构明细响应项
+type TopInstItem struct {
+	TradeDate string      `json:"trade_date"` // 交易日期
+	TSCode    string      `json:"ts_code"`    // 股票代码
+	Exalter   string      `json:"exalter"`    // 营业部名称
+	Side      TopInstSide `json:"side"`       // 买卖类型：0 买入金额最大的前5名，1 卖出金额最大的前5名
+	Buy       float64     `json:"buy"`        // 买入额（元）
+	BuyRate   float64     `json:"buy_rate"`   // 买入占总成交比例
+	Sell      float64     `json:"sell"`       // 卖出额（元）
+	SellRate  float64     `json:"sell_rate"`  // 卖出占总成交比例
+	NetBuy    float64     `json:"net_buy"`    // 净成交额（元）
+	Reason    string      `json:"reason"`     // 上榜理由
+}
+
+// TopInst 获取龙虎榜机构明细数据（自动处理分页）
+// 根据交易日期获取上榜股票买卖前五名营业部的成交情况，可使用 GroupTopInst 按股票分组
+func TopInst(c tushare.Querier, params *TopInstParams, opts ...tushare.QueryOption) ([]*TopInstItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("top_inst", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*TopInstItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
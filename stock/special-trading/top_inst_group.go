@@ -0,0 +1,65 @@
+package specialtrading
+
+import (
+	"sort"
+)
+
+// TopInstGroup 单只股票在某个交易日的龙虎榜买卖席位
+type TopInstGroup struct {
+	TradeDate string         // 交易日期
+	TSCode    string         // 股票代码
+	Buyers    []*TopInstItem // 买入金额最大的席位，按买入额降序排列
+	Sellers   []*TopInstItem // 卖出金额最大的席位，按卖出额降序排列
+}
+
+// NetBuy 返回全部上榜席位的净成交额合计（同一席位因多个上榜理由重复出现时会重复计算）
+func (g *TopInstGroup) NetBuy() float64 {
+	var total float64
+	for _, item := range g.Buyers {
+		total += item.NetBuy
+	}
+	for _, item := range g.Sellers {
+		total += item.NetBuy
+	}
+	return total
+}
+
+// GroupTopInst 将龙虎榜机构明细按 trade_date、ts_code 分组，区分买入席位和卖出席位
+//
+// side 为 TopInstSideBuy 的记录归入 Buyers，其余归入 Sellers。
+// 同一股票因多个理由上榜时，同一席位可能出现多次，分组时保留全部记录，可按 Reason 区分。
+// 结果按 trade_date、ts_code 升序排列。
+func GroupTopInst(items []*TopInstItem) []*TopInstGroup {
+	byKey := make(map[[2]string]*TopInstGroup)
+	var groups []*TopInstGroup
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		key := [2]string{item.TradeDate, item.TSCode}
+		group, ok := byKey[key]
+		if !ok {
+			group = &TopInstGroup{TradeDate: item.TradeDate, TSCode: item.TSCode}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+		if item.Side == TopInstSideBuy {
+			group.Buyers = append(group.Buyers, item)
+		} else {
+			group.Sellers = append(group.Sellers, item)
+		}
+	}
+
+	for _, group := range groups {
+		buyers, sellers := group.Buyers, group.Sellers
+		sort.SliceStable(buyers, func(a, b int) bool { return buyers[a].Buy > buyers[b].Buy })
+		sort.SliceStable(sellers, func(a, b int) bool { return sellers[a].Sell > sellers[b].Sell })
+	}
+	sort.Slice(groups, func(a, b int) bool {
+		if groups[a].TradeDate != groups[b].TradeDate {
+			return groups[a].TradeDate < groups[b].TradeDate
+		}
+		return groups[a].TSCode < groups[b].TSCode
+	})
+	return groups
+}
@@ -0,0 +1,93 @@
+// Package specialtrading 提供 Tushare 龙虎榜、大宗交易等特色交易数据接口
+// 文档参考: https://tushare.pro/document/2?doc_id=106
+package specialtrading
+
+import (
+	"strings"
+
+	tushare "github.com/fletcherlau/go-tushare"
+)
+
+// TopListField 返回字段常量
+const (
+	TopListFieldTradeDate    = "trade_date"    // 交易日期
+	TopListFieldTSCode       = "ts_code"       // 股票代码
+	TopListFieldName         = "name"          // 股票名称
+	TopListFieldClose        = "close"         // 收盘价
+	TopListFieldPctChange    = "pct_change"    // 涨跌幅
+	TopListFieldTurnoverRate = "turnover_rate" // 换手率
+	TopListFieldAmount       = "amount"        // 总成交额（元）
+	TopListFieldLSell        = "l_sell"        // 龙虎榜卖出额（元）
+	TopListFieldLBuy         = "l_buy"         // 龙虎榜买入额（元）
+	TopListFieldLAmount      = "l_amount"      // 龙虎榜成交额（元）
+	TopListFieldNetAmount    = "net_amount"    // 龙虎榜净买入额（元）
+	TopListFieldNetRate      = "net_rate"      // 龙虎榜净买额占比
+	TopListFieldAmountRate   = "amount_rate"   // 龙虎榜成交额占比
+	TopListFieldFloatValues  = "float_values"  // 当日流通市值（元）
+	TopListFieldReason       = "reason"        // 上榜理由
+)
+
+// TopListParams 龙虎榜每日明细参数
+// 接口: top_list
+// 描述: 龙虎榜每日交易明细，单次请求返回最大10000行数据，可通过参数循环获取全部历史。
+// 文档: https://tushare.pro/document/2?doc_id=106
+type TopListParams struct {
+	TradeDate string   // 交易日期（必填，YYYYMMDD）
+	TSCode    string   // 股票代码
+	Fields    []string // 返回字段列表
+}
+
+// TopListItem 龙虎榜每日明细响应项
+type TopListItem struct {
+	TradeDate    string  `json:"trade_date"`    // 交易日期
+	TSCode       string  `json:"ts_code"`       // 股票代码
+	Name         string  `json:"name"`          // 股票名称
+	Close        float64 `json:"close"`         // 收盘价
+	PctChange    float64 `json:"pct_change"`    // 涨跌幅
+	TurnoverRate float64 `json:"turnover_rate"` // 换手率
+	Amount       float64 `json:"amount"`        // 总成交额（元）
+	LSell        float64 `json:"l_sell"`        // 龙虎榜卖出额（元）
+	LBuy         float64 `json:"l_buy"`         // 龙虎榜买入额（元）
+	LAmount      float64 `json:"l_amount"`      // 龙虎榜成交额（元）
+	NetAmount    float64 `json:"net_amount"`    // 龙虎榜净买入额（元）
+	NetRate      float64 `json:"net_rate"`      // 龙虎榜净买额占比
+	AmountRate   float64 `json:"amount_rate"`   // 龙虎榜成交额占比
+	FloatValues  float64 `json:"float_values"`  // 当日流通市值（元）
+	Reason       string  `json:"reason"`        // 上榜理由
+}
+
+// TopList 获取龙虎榜每日明细数据（自动处理分页）
+// 根据交易日期获取当日上榜股票的龙虎榜成交情况
+func TopList(c tushare.Querier, params *TopListParams, opts ...tushare.QueryOption) ([]*TopListItem, error) {
+	reqParams := make(map[string]interface{})
+	if params.TradeDate != "" {
+		reqParams["trade_date"] = params.TradeDate
+	}
+	if params.TSCode != "" {
+		reqParams["ts_code"] = params.TSCode
+	}
+
+	fields := ""
+	if len(params.Fields) > 0 {
+		fields = strings.Join(params.Fields, ",")
+	}
+
+	resp, err := c.Query("top_list", reqParams, fields, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, &tushare.APIError{
+			Code: resp.Code,
+			Msg:  resp.Msg,
+		}
+	}
+
+	var items []*TopListItem
+	if err := resp.ToStruct(&items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,266 @@
+package tushare
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	dateType     = reflect.TypeOf(Date{})
+	dateTimeType = reflect.TypeOf(DateTime{})
+)
+
+// structField 结构体字段与列的对应关系
+type structField struct {
+	name  string // 列名（json 标签名）
+	index []int
+	typ   ColumnType
+}
+
+// structFields 返回结构体类型中可导出为列的字段，列名取自 json 标签，没有标签时使用字段名
+func structFields(t reflect.Type) []structField {
+	fields := make([]structField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		fields = append(fields, structField{name: name, index: f.Index, typ: columnTypeOf(f.Type)})
+	}
+	return fields
+}
+
+// columnTypeOf 返回 Go 类型对应的列类型，指针按其指向的类型处理
+func columnTypeOf(t reflect.Type) ColumnType {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType || t == dateType {
+		return ColumnDate
+	}
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return ColumnFloat64
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ColumnInt64
+	default:
+		return ColumnString
+	}
+}
+
+// structElemType 返回切片元素对应的结构体类型，元素可以是结构体或结构体指针
+func structElemType(t reflect.Type) (reflect.Type, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("element type must be a struct or struct pointer, got %s", t)
+	}
+	return t, nil
+}
+
+// DataFrameFromStructs 将结构体（或结构体指针）切片转换为 DataFrame，如 []*market.DailyItem
+//
+// 列名取自字段的 json 标签，列顺序与字段顺序一致；列类型由字段类型决定
+// （浮点数为 float64，整数为 int64，time.Time 和 Date 为日期，其余为字符串；
+// DateTime 为 "2006-01-02 15:04:05" 格式的字符串，保留时分秒）。
+// nil 元素、nil 指针字段和零值 Date、DateTime 记为空值。
+func DataFrameFromStructs[T any](items []T) (*DataFrame, error) {
+	return dataFrameFromSlice(reflect.ValueOf(items))
+}
+
+// dataFrameFromSlice 将结构体（或结构体指针）切片转换为 DataFrame
+func dataFrameFromSlice(rv reflect.Value) (*DataFrame, error) {
+	elemType, err := structElemType(rv.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := structFields(elemType)
+	n := rv.Len()
+	series := make([]*Series, len(fields))
+	for j, f := range fields {
+		series[j] = newSeries(f.name, f.typ, n)
+	}
+
+	for i := 0; i < n; i++ {
+		item := rv.Index(i)
+		if item.Kind() == reflect.Pointer {
+			if item.IsNil() {
+				for _, s := range series {
+					s.appendNull()
+				}
+				continue
+			}
+			item = item.Elem()
+		}
+		for j, f := range fields {
+			v := item.FieldByIndex(f.index)
+			if v.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					series[j].appendNull()
+					continue
+				}
+				v = v.Elem()
+			}
+			series[j].appendValue(plainValue(v))
+		}
+	}
+	return NewDataFrameFromSeries(series...)
+}
+
+// plainValue 将字段值转换为基础类型（如自定义的 string 类型转换为 string），便于按列类型追加
+func plainValue(v reflect.Value) interface{} {
+	if d, ok := v.Interface().(Date); ok {
+		if d.IsZero() {
+			return nil
+		}
+		return d.Time
+	}
+	if d, ok := v.Interface().(DateTime); ok {
+		if d.IsZero() {
+			return nil
+		}
+		return d.String()
+	}
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.String:
+		return v.String()
+	default:
+		return v.Interface()
+	}
+}
+
+// ToStruct 将 DataFrame 转换为结构体切片，v 为指向切片的指针（如 *[]*market.DailyItem）
+//
+// 按 json 标签匹配列，没有对应列的字段和空值保持零值（指针字段为 nil）。
+// 日期列可以写入 time.Time、Date 或字符串（YYYYMMDD）字段，数值列可以写入任意数值字段，
+// "2006-01-02 15:04:05" 格式的字符串列可以写入 DateTime 字段。
+func (df *DataFrame) ToStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("ToStruct requires a pointer to a slice, got %T", v)
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+	structType, err := structElemType(elemType)
+	if err != nil {
+		return err
+	}
+
+	type binding struct {
+		series *Series
+		index  []int
+	}
+	var bindings []binding
+	for _, f := range structFields(structType) {
+		if s := df.Column(f.name); s != nil {
+			bindings = append(bindings, binding{series: s, index: f.index})
+		}
+	}
+
+	result := reflect.MakeSlice(slice.Type(), df.nrows, df.nrows)
+	for i := 0; i < df.nrows; i++ {
+		item := result.Index(i)
+		if elemType.Kind() == reflect.Pointer {
+			item.Set(reflect.New(structType))
+			item = item.Elem()
+		}
+		for _, b := range bindings {
+			if b.series.nulls[i] {
+				continue
+			}
+			field := item.FieldByIndex(b.index)
+			if err := setField(field, b.series, i); err != nil {
+				return fmt.Errorf("row %d: %w", i, err)
+			}
+		}
+	}
+	slice.Set(result)
+	return nil
+}
+
+// setField 将第 i 个非空元素写入字段，指针字段会分配新值
+func setField(field reflect.Value, s *Series, i int) error {
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := setField(ptr.Elem(), s, i); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	if field.Type() == timeType {
+		d, ok := toDate(s.Value(i))
+		if !ok {
+			return fmt.Errorf("cannot convert column %s value %q to time.Time", s.name, s.String(i))
+		}
+		field.Set(reflect.ValueOf(d))
+		return nil
+	}
+	if field.Type() == dateType {
+		d, ok := toDate(s.Value(i))
+		if !ok {
+			return fmt.Errorf("cannot convert column %s value %q to Date", s.name, s.String(i))
+		}
+		field.Set(reflect.ValueOf(Date{Time: d}))
+		return nil
+	}
+	if field.Type() == dateTimeType {
+		d, err := ParseDateTime(s.String(i))
+		if err != nil {
+			return fmt.Errorf("cannot convert column %s value %q to DateTime", s.name, s.String(i))
+		}
+		field.Set(reflect.ValueOf(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(formatCell(s, i))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if f, ok := s.Float64(i); ok {
+			field.SetFloat(f)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := toInt64(s.Value(i)); ok {
+			field.SetInt(n)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := toInt64(s.Value(i)); ok && n >= 0 {
+			field.SetUint(uint64(n))
+			return nil
+		}
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(s.String(i)); err == nil {
+			field.SetBool(b)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot convert column %s value %q to %s", s.name, s.String(i), field.Type())
+}
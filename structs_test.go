@@ -0,0 +1,105 @@
+package tushare
+
+import (
+	"testing"
+	"time"
+)
+
+type structTestItem struct {
+	TSCode    string    `json:"ts_code"`
+	TradeDate string    `json:"trade_date"`
+	ListDate  time.Time `json:"list_date"`
+	Close     float64   `json:"close"`
+	Vol       int64     `json:"vol"`
+	PE        *float64  `json:"pe"`
+	Ignored   string    `json:"-"`
+}
+
+func TestDataFrameFromStructs(t *testing.T) {
+	pe := 5.2
+	items := []*structTestItem{
+		{TSCode: "000001.SZ", TradeDate: "20240102", ListDate: time.Date(1991, 4, 3, 0, 0, 0, 0, time.UTC), Close: 9.39, Vol: 100, PE: &pe},
+		nil,
+		{TSCode: "600000.SH", TradeDate: "20240102", Close: 7.1, Vol: 200},
+	}
+
+	df, err := DataFrameFromStructs(items)
+	if err != nil {
+		t.Fatalf("DataFrameFromStructs 失败: %v", err)
+	}
+
+	assertStrings(t, df.Columns, []string{"ts_code", "trade_date", "list_date", "close", "vol", "pe"})
+	want := map[string]ColumnType{
+		"ts_code":   ColumnString,
+		"list_date": ColumnDate,
+		"close":     ColumnFloat64,
+		"vol":       ColumnInt64,
+		"pe":        ColumnFloat64,
+	}
+	for col, typ := range want {
+		if got := df.Column(col).Type(); got != typ {
+			t.Errorf("期望 %s 列类型为 %s，但得到 %s", col, typ, got)
+		}
+	}
+	if !df.IsNull(1, "ts_code") || !df.IsNull(2, "pe") {
+		t.Error("期望 nil 元素和 nil 指针字段为空值")
+	}
+	if df.GetString(0, "list_date") != "19910403" {
+		t.Errorf("期望 list_date 为 19910403，但得到 %s", df.GetString(0, "list_date"))
+	}
+
+	if _, err := DataFrameFromStructs([]string{"a"}); err == nil {
+		t.Error("期望非结构体切片返回错误")
+	}
+}
+
+func TestDataFrame_ToStruct(t *testing.T) {
+	df := NewDataFrame(&Response{Data: &ResponseData{
+		Fields: []string{"ts_code", "trade_date", "list_date", "close", "vol", "pe", "extra"},
+		Items: [][]interface{}{
+			{"000001.SZ", "20240102", "19910403", 9.39, 100.0, 5.2, "x"},
+			{"600000.SH", "20240103", nil, 7.1, 200.0, nil, "y"},
+		},
+	}})
+
+	var items []*structTestItem
+	if err := df.ToStruct(&items); err != nil {
+		t.Fatalf("ToStruct 失败: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("期望 2 条记录，但得到 %d", len(items))
+	}
+
+	first := items[0]
+	// 日期列可以写入字符串字段和 time.Time 字段
+	if first.TradeDate != "20240102" || !first.ListDate.Equal(time.Date(1991, 4, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("日期字段不正确: %s %v", first.TradeDate, first.ListDate)
+	}
+	if first.Close != 9.39 || first.Vol != 100 || first.PE == nil || *first.PE != 5.2 {
+		t.Errorf("数值字段不正确: %+v", first)
+	}
+	if items[1].PE != nil || !items[1].ListDate.IsZero() {
+		t.Error("期望空值保持零值")
+	}
+
+	// 结构体切片（非指针）往返
+	var values []structTestItem
+	if err := df.ToStruct(&values); err != nil {
+		t.Fatalf("ToStruct 失败: %v", err)
+	}
+	back, _ := DataFrameFromStructs(values)
+	if back.GetString(1, "ts_code") != "600000.SH" || back.GetInt(1, "vol") != 200 {
+		t.Error("往返转换结果不正确")
+	}
+
+	if err := df.ToStruct(items); err == nil {
+		t.Error("期望非指针参数返回错误")
+	}
+
+	var bad []struct {
+		TSCode float64 `json:"ts_code"`
+	}
+	if err := df.ToStruct(&bad); err == nil {
+		t.Error("期望无法转换的值返回错误")
+	}
+}
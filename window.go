@@ -0,0 +1,311 @@
+package tushare
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// WindowSpec 窗口定义
+type WindowSpec struct {
+	PartitionBy string // 分区列（如 ts_code），为空表示整个 DataFrame 为一个分区
+	OrderBy     string // 分区内的排序列（如 trade_date，升序），为空表示保持行顺序
+}
+
+// Window 窗口计算，由 DataFrame.Window 创建
+//
+// 计算在每个分区内按排序列进行，结果 Series 与原 DataFrame 的行一一对应，
+// 可通过 DataFrame.WithColumn 添加到 DataFrame 中。
+// 这使得多只股票的日线数据无需拆分即可直接计算收益率、均线等指标。
+type Window struct {
+	df         *DataFrame
+	partitions [][]int // 每个分区内按排序列排列的行号
+}
+
+// Window 按 spec 创建窗口
+func (df *DataFrame) Window(spec WindowSpec) (*Window, error) {
+	var partitions [][]int
+	if spec.PartitionBy == "" {
+		partitions = [][]int{rowRange(0, df.nrows)}
+	} else {
+		g, err := df.GroupBy(spec.PartitionBy)
+		if err != nil {
+			return nil, err
+		}
+		partitions = g.groups
+	}
+
+	if spec.OrderBy != "" {
+		order := df.Column(spec.OrderBy)
+		if order == nil {
+			return nil, columnNotFound(spec.OrderBy)
+		}
+		for _, rows := range partitions {
+			sort.SliceStable(rows, func(a, b int) bool {
+				return order.compare(rows[a], rows[b]) < 0
+			})
+		}
+	}
+
+	return &Window{df: df, partitions: partitions}, nil
+}
+
+// WithColumn 添加或替换列（按 Series 名称），返回新的 DataFrame
+func (df *DataFrame) WithColumn(s *Series) (*DataFrame, error) {
+	if s.Len() != df.nrows && len(df.series) > 0 {
+		return nil, fmt.Errorf("column %s has %d rows, expected %d", s.name, s.Len(), df.nrows)
+	}
+
+	series := make([]*Series, 0, len(df.series)+1)
+	replaced := false
+	for _, existing := range df.series {
+		if existing.name == s.name {
+			series = append(series, s)
+			replaced = true
+			continue
+		}
+		series = append(series, existing)
+	}
+	if !replaced {
+		series = append(series, s)
+	}
+	return NewDataFrameFromSeries(series...)
+}
+
+// Shift 将列在分区内平移 n 行（n>0 取前 n 行的值，n<0 取后 n 行的值），保留原列类型
+// 结果列名为 <col>_shift<n>（如 close_shift1），不会在 WithColumn 时覆盖原列
+func (w *Window) Shift(col string, n int) (*Series, error) {
+	s := w.df.Column(col)
+	if s == nil {
+		return nil, columnNotFound(col)
+	}
+
+	idx := make([]int, w.df.nrows)
+	for _, rows := range w.partitions {
+		for k, i := range rows {
+			src := k - n
+			if src < 0 || src >= len(rows) {
+				idx[i] = -1
+			} else {
+				idx[i] = rows[src]
+			}
+		}
+	}
+	return s.take(idx).Rename(fmt.Sprintf("%s_shift%d", col, n)), nil
+}
+
+// Diff 分区内与前 n 行的差值，结果列名为 <col>_diff<n>
+func (w *Window) Diff(col string, n int) (*Series, error) {
+	return w.lagged(col, fmt.Sprintf("%s_diff%d", col, n), n, func(cur, prev float64) (float64, bool) {
+		return cur - prev, true
+	})
+}
+
+// PctChange 分区内相对前 n 行的变化率（0.01 表示 1%），前值为 0 时为空值，结果列名为 <col>_pct_change<n>
+func (w *Window) PctChange(col string, n int) (*Series, error) {
+	return w.lagged(col, fmt.Sprintf("%s_pct_change%d", col, n), n, func(cur, prev float64) (float64, bool) {
+		if prev == 0 {
+			return 0, false
+		}
+		return cur/prev - 1, true
+	})
+}
+
+// lagged 计算当前值与前 n 行的函数，任一侧为空值时结果为空值
+func (w *Window) lagged(col, name string, n int, fn func(cur, prev float64) (float64, bool)) (*Series, error) {
+	return w.apply(col, name, func(values []float64, nulls []bool, out []float64, outNulls []bool) {
+		for k := range values {
+			p := k - n
+			if p < 0 || p >= len(values) || nulls[k] || nulls[p] {
+				outNulls[k] = true
+				continue
+			}
+			out[k], outNulls[k] = fn(values[k], values[p])
+			outNulls[k] = !outNulls[k]
+		}
+	})
+}
+
+// apply 在每个分区内按顺序取出数值并计算，结果写回原行位置，结果列名为 name
+func (w *Window) apply(col, name string, fn func(values []float64, nulls []bool, out []float64, outNulls []bool)) (*Series, error) {
+	s := w.df.Column(col)
+	if s == nil {
+		return nil, columnNotFound(col)
+	}
+
+	result := make([]float64, w.df.nrows)
+	resultNulls := make([]bool, w.df.nrows)
+	for _, rows := range w.partitions {
+		values := make([]float64, len(rows))
+		nulls := make([]bool, len(rows))
+		for k, i := range rows {
+			f, ok := s.Float64(i)
+			values[k], nulls[k] = f, !ok
+		}
+
+		out := make([]float64, len(rows))
+		outNulls := make([]bool, len(rows))
+		fn(values, nulls, out, outNulls)
+
+		for k, i := range rows {
+			result[i], resultNulls[i] = out[k], outNulls[k]
+		}
+	}
+	return NewFloat64Series(name, result, resultNulls), nil
+}
+
+// ==================== 滚动与扩展窗口 ====================
+
+// Rolling 滚动窗口
+// 聚合结果的列名为 <col>_rolling<size>_<聚合>（如 close_rolling5_mean），扩展窗口为 <col>_expanding_<聚合>
+type Rolling struct {
+	w          *Window
+	size       int  // 窗口行数
+	expanding  bool // 扩展窗口，忽略 size
+	minPeriods int
+}
+
+// Rolling 创建包含当前行在内最近 size 行的滚动窗口，默认至少需要 size 个非空值
+// size 必须大于 0，否则聚合时返回错误
+func (w *Window) Rolling(size int) *Rolling {
+	return &Rolling{w: w, size: size, minPeriods: size}
+}
+
+// Expanding 创建从分区起点到当前行的扩展窗口，默认至少需要 1 个非空值
+func (w *Window) Expanding() *Rolling {
+	return &Rolling{w: w, expanding: true, minPeriods: 1}
+}
+
+// MinPeriods 设置计算结果所需的最少非空值个数，不足时结果为空值；n 不能为负数
+func (r *Rolling) MinPeriods(n int) *Rolling {
+	c := *r
+	c.minPeriods = n
+	return &c
+}
+
+// Mean 窗口平均值
+func (r *Rolling) Mean(col string) (*Series, error) {
+	return r.aggregate(col, "mean", 1, mean)
+}
+
+// Sum 窗口求和
+func (r *Rolling) Sum(col string) (*Series, error) {
+	return r.aggregate(col, "sum", 1, func(values []float64) float64 {
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		return sum
+	})
+}
+
+// Std 窗口样本标准差，非空值少于 2 个时为空值
+func (r *Rolling) Std(col string) (*Series, error) {
+	return r.aggregate(col, "std", 2, std)
+}
+
+// Min 窗口最小值
+func (r *Rolling) Min(col string) (*Series, error) {
+	return r.aggregate(col, "min", 1, func(values []float64) float64 {
+		m := values[0]
+		for _, v := range values[1:] {
+			m = math.Min(m, v)
+		}
+		return m
+	})
+}
+
+// Max 窗口最大值
+func (r *Rolling) Max(col string) (*Series, error) {
+	return r.aggregate(col, "max", 1, func(values []float64) float64 {
+		m := values[0]
+		for _, v := range values[1:] {
+			m = math.Max(m, v)
+		}
+		return m
+	})
+}
+
+// aggregate 对每个窗口内的非空值调用 fn，stat 为聚合名称，atLeast 为 fn 要求的最少值个数
+func (r *Rolling) aggregate(col, stat string, atLeast int, fn func(values []float64) float64) (*Series, error) {
+	if !r.expanding && r.size <= 0 {
+		return nil, fmt.Errorf("rolling window size must be positive, got %d", r.size)
+	}
+	if r.minPeriods < 0 {
+		return nil, fmt.Errorf("rolling min periods must not be negative, got %d", r.minPeriods)
+	}
+	minPeriods := r.minPeriods
+	if minPeriods < atLeast {
+		minPeriods = atLeast
+	}
+
+	name := fmt.Sprintf("%s_rolling%d_%s", col, r.size, stat)
+	if r.expanding {
+		name = fmt.Sprintf("%s_expanding_%s", col, stat)
+	}
+
+	return r.w.apply(col, name, func(values []float64, nulls []bool, out []float64, outNulls []bool) {
+		window := make([]float64, 0, min(r.size, len(values)))
+		for k := range values {
+			start := 0
+			if !r.expanding && k-r.size+1 > 0 {
+				start = k - r.size + 1
+			}
+			window = window[:0]
+			for p := start; p <= k; p++ {
+				if !nulls[p] {
+					window = append(window, values[p])
+				}
+			}
+			if len(window) < minPeriods {
+				outNulls[k] = true
+				continue
+			}
+			out[k] = fn(window)
+		}
+	})
+}
+
+// ==================== 指数加权 ====================
+
+// EWM 指数加权窗口
+type EWM struct {
+	w     *Window
+	alpha float64
+}
+
+// EWM 创建平滑系数为 alpha（0 < alpha <= 1）的指数加权窗口
+func (w *Window) EWM(alpha float64) *EWM {
+	return &EWM{w: w, alpha: alpha}
+}
+
+// EWMSpan 按跨度（span >= 1）创建指数加权窗口，alpha = 2 / (span + 1)
+func (w *Window) EWMSpan(span float64) *EWM {
+	return w.EWM(2 / (span + 1))
+}
+
+// Mean 指数加权平均（与 pandas 的 adjust=True 一致），结果列名为 <col>_ewm_mean
+// 空值不参与加权但权重照常衰减，空值所在行沿用之前的结果
+func (e *EWM) Mean(col string) (*Series, error) {
+	if !(e.alpha > 0 && e.alpha <= 1) {
+		return nil, fmt.Errorf("ewm alpha must be in (0, 1], got %v", e.alpha)
+	}
+	decay := 1 - e.alpha
+
+	return e.w.apply(col, col+"_ewm_mean", func(values []float64, nulls []bool, out []float64, outNulls []bool) {
+		num, den := 0.0, 0.0
+		for k := range values {
+			num *= decay
+			den *= decay
+			if !nulls[k] {
+				num += values[k]
+				den++
+			}
+			if den == 0 {
+				outNulls[k] = true
+				continue
+			}
+			out[k] = num / den
+		}
+	})
+}
@@ -0,0 +1,224 @@
+package tushare
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// newMultiStockFrame 两只股票交错、日期乱序的日线数据
+func newMultiStockFrame(t *testing.T) *DataFrame {
+	t.Helper()
+	codes := []string{"000001.SZ", "600000.SH", "000001.SZ", "600000.SH", "000001.SZ", "000001.SZ", "600000.SH"}
+	dates := []string{"20240103", "20240102", "20240102", "20240103", "20240104", "20240105", "20240104"}
+	closes := []float64{11, 20, 10, 22, 12, 15, 0}
+	nulls := []bool{false, false, false, false, true, false, false}
+
+	return newTestFrame(t,
+		NewStringSeries("ts_code", codes, nil),
+		NewStringSeries("trade_date", dates, nil),
+		NewFloat64Series("close", closes, nulls),
+	)
+}
+
+// assertFloats 比较列的值，NaN 表示期望为空值
+func assertFloats(t *testing.T, s *Series, want []float64) {
+	t.Helper()
+	if s.Len() != len(want) {
+		t.Fatalf("期望 %d 行，但得到 %d", len(want), s.Len())
+	}
+	for i, w := range want {
+		f, ok := s.Float64(i)
+		if math.IsNaN(w) {
+			if ok {
+				t.Errorf("第 %d 行期望为空值，但得到 %f", i, f)
+			}
+			continue
+		}
+		if !ok || math.Abs(f-w) > 1e-9 {
+			t.Errorf("第 %d 行期望 %f，但得到 %f（非空: %v）", i, w, f, ok)
+		}
+	}
+}
+
+func TestWindow_ShiftDiffPctChange(t *testing.T) {
+	df := newMultiStockFrame(t)
+	w, err := df.Window(WindowSpec{PartitionBy: "ts_code", OrderBy: "trade_date"})
+	if err != nil {
+		t.Fatalf("Window 失败: %v", err)
+	}
+
+	nan := math.NaN()
+
+	// 000001.SZ 按日期：10, 11, null, 15；600000.SH 按日期：20, 22, 0
+	prev, err := w.Shift("close", 1)
+	if err != nil {
+		t.Fatalf("Shift 失败: %v", err)
+	}
+	assertFloats(t, prev, []float64{10, nan, nan, 20, 11, nan, 22})
+
+	next, _ := w.Shift("trade_date", -1)
+	if next.Type() != ColumnString {
+		t.Errorf("期望 Shift 保留列类型，但得到 %s", next.Type())
+	}
+	assertStrings(t, next.Strings()[:2], []string{"20240104", "20240103"})
+
+	diff, _ := w.Diff("close", 1)
+	assertFloats(t, diff, []float64{1, nan, nan, 2, nan, nan, -22})
+
+	pct, _ := w.PctChange("close", 1)
+	assertFloats(t, pct, []float64{0.1, nan, nan, 0.1, nan, nan, -1})
+
+	// 前值为 0 时变化率为空值
+	back, _ := w.PctChange("close", -1)
+	assertFloats(t, back, []float64{nan, 20.0/22 - 1, 10.0/11 - 1, nan, nan, nan, nan})
+}
+
+func TestWindow_Rolling(t *testing.T) {
+	df := newMultiStockFrame(t)
+	w, _ := df.Window(WindowSpec{PartitionBy: "ts_code", OrderBy: "trade_date"})
+	nan := math.NaN()
+
+	mean, err := w.Rolling(2).Mean("close")
+	if err != nil {
+		t.Fatalf("Rolling 失败: %v", err)
+	}
+	// 窗口内非空值不足 2 个时为空值
+	assertFloats(t, mean, []float64{10.5, nan, nan, 21, nan, nan, 11})
+
+	// 放宽最少非空值个数
+	mean, _ = w.Rolling(2).MinPeriods(1).Mean("close")
+	assertFloats(t, mean, []float64{10.5, 20, 10, 21, 11, 15, 11})
+
+	sum, _ := w.Rolling(3).MinPeriods(1).Sum("close")
+	assertFloats(t, sum, []float64{21, 20, 10, 42, 21, 26, 42})
+
+	min, _ := w.Rolling(3).MinPeriods(1).Min("close")
+	assertFloats(t, min, []float64{10, 20, 10, 20, 10, 11, 0})
+
+	max, _ := w.Rolling(3).MinPeriods(1).Max("close")
+	assertFloats(t, max, []float64{11, 20, 10, 22, 11, 15, 22})
+
+	// 标准差至少需要 2 个值
+	sd, _ := w.Rolling(2).MinPeriods(1).Std("close")
+	assertFloats(t, sd, []float64{math.Sqrt(0.5), nan, nan, math.Sqrt(2), nan, nan, math.Sqrt(242)})
+}
+
+func TestWindow_Expanding(t *testing.T) {
+	df := newMultiStockFrame(t)
+	w, _ := df.Window(WindowSpec{PartitionBy: "ts_code", OrderBy: "trade_date"})
+
+	mean, err := w.Expanding().Mean("close")
+	if err != nil {
+		t.Fatalf("Expanding 失败: %v", err)
+	}
+	assertFloats(t, mean, []float64{10.5, 20, 10, 21, 10.5, 12, 14})
+
+	max, _ := w.Expanding().Max("close")
+	assertFloats(t, max, []float64{11, 20, 10, 22, 11, 15, 22})
+}
+
+func TestWindow_EWM(t *testing.T) {
+	df := newTestFrame(t,
+		NewFloat64Series("close", []float64{1, 2, 0, 4}, []bool{false, false, true, false}),
+	)
+	w, err := df.Window(WindowSpec{})
+	if err != nil {
+		t.Fatalf("Window 失败: %v", err)
+	}
+
+	ewm, err := w.EWM(0.5).Mean("close")
+	if err != nil {
+		t.Fatalf("EWM 失败: %v", err)
+	}
+	// 与 pandas ewm(alpha=0.5).mean() 一致：空值沿用前值，权重照常衰减
+	assertFloats(t, ewm, []float64{1, 5.0 / 3, 5.0 / 3, 4.625 / 1.375})
+
+	span, _ := w.EWMSpan(3).Mean("close")
+	assertFloats(t, span, []float64{1, 5.0 / 3, 5.0 / 3, 4.625 / 1.375})
+
+	if _, err := w.EWM(0).Mean("close"); err == nil {
+		t.Error("期望 alpha 为 0 时返回错误")
+	}
+}
+
+func TestWindow_WithColumn(t *testing.T) {
+	df := newMultiStockFrame(t)
+	w, _ := df.Window(WindowSpec{PartitionBy: "ts_code", OrderBy: "trade_date"})
+
+	pct, _ := w.PctChange("close", 1)
+	result, err := df.WithColumn(pct.Rename("pct_chg"))
+	if err != nil {
+		t.Fatalf("WithColumn 失败: %v", err)
+	}
+	assertStrings(t, result.Columns, []string{"ts_code", "trade_date", "close", "pct_chg"})
+	if got := result.GetFloat64(0, "pct_chg"); math.Abs(got-0.1) > 1e-9 {
+		t.Errorf("期望第 0 行 pct_chg 为 0.1，但得到 %f", got)
+	}
+
+	// 同名列原位替换
+	replaced, _ := result.WithColumn(NewFloat64Series("close", make([]float64, 7), nil))
+	assertStrings(t, replaced.Columns, result.Columns)
+	if replaced.GetFloat64(0, "close") != 0 {
+		t.Error("期望 close 列被替换")
+	}
+
+	// 窗口结果使用派生列名，直接添加不会覆盖原列
+	diff, _ := w.Diff("close", 1)
+	ma, _ := w.Rolling(2).Mean("close")
+	high, _ := w.Expanding().Max("close")
+	ema, _ := w.EWM(0.5).Mean("close")
+	prev, _ := w.Shift("close", -1)
+	for _, s := range []*Series{diff, ma, high, ema, prev} {
+		if result, err = result.WithColumn(s); err != nil {
+			t.Fatalf("WithColumn 失败: %v", err)
+		}
+	}
+	assertStrings(t, result.Columns, []string{"ts_code", "trade_date", "close", "pct_chg",
+		"close_diff1", "close_rolling2_mean", "close_expanding_max", "close_ewm_mean", "close_shift-1"})
+	if result.GetFloat64(0, "close") != 11 {
+		t.Error("期望 close 列保持不变")
+	}
+
+	if _, err := df.WithColumn(NewFloat64Series("x", []float64{1}, nil)); err == nil {
+		t.Error("期望行数不一致时返回错误")
+	}
+}
+
+func TestWindow_Errors(t *testing.T) {
+	df := newMultiStockFrame(t)
+
+	if _, err := df.Window(WindowSpec{PartitionBy: "missing"}); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+	if _, err := df.Window(WindowSpec{OrderBy: "missing"}); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+
+	w, _ := df.Window(WindowSpec{})
+	if _, err := w.Rolling(3).Mean("missing"); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("期望 ErrColumnNotFound，但得到 %v", err)
+	}
+}
+
+func TestWindow_InvalidArguments(t *testing.T) {
+	df := newMultiStockFrame(t)
+	w, _ := df.Window(WindowSpec{PartitionBy: "ts_code", OrderBy: "trade_date"})
+
+	for _, size := range []int{0, -1} {
+		if _, err := w.Rolling(size).Mean("close"); err == nil {
+			t.Errorf("期望窗口大小为 %d 时返回错误", size)
+		}
+	}
+	if _, err := w.Rolling(2).MinPeriods(-1).Sum("close"); err == nil {
+		t.Error("期望 MinPeriods 为负数时返回错误")
+	}
+	if _, err := w.Expanding().MinPeriods(0).Max("close"); err != nil {
+		t.Errorf("期望扩展窗口可以使用 MinPeriods(0)，但得到错误: %v", err)
+	}
+	for _, span := range []float64{0, -1, -3, math.NaN()} {
+		if _, err := w.EWMSpan(span).Mean("close"); err == nil {
+			t.Errorf("期望 span 为 %v 时返回错误", span)
+		}
+	}
+}